@@ -0,0 +1,46 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudCasaTenantProfileSpec defines the CloudCasa permissions granted to the owners of the selected Tenants.
+type CloudCasaTenantProfileSpec struct {
+	// Selects the Tenants the profile is applied to, unless a Tenant refers to a profile
+	// using the annotation cloudcasa.io/profile.
+	TenantSelector *metav1.LabelSelector `json:"tenantSelector,omitempty"`
+	// Permissions granted on all the CloudCasa resources, such as policies.create, or kubebackups.create.
+	GlobalPermissions []string `json:"globalPermissions,omitempty"`
+	// Permissions granted on the CloudCasa Kubernetes cluster hosting the Tenant, such as kubeclusters.backup.
+	ClusterPermissions []string `json:"clusterPermissions,omitempty"`
+	// Permissions granted on the CloudCasa Kubernetes namespaces of the Tenant, such as kubenamespaces.read.
+	NamespacePermissions []string `json:"namespacePermissions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster,shortName=cctp
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// CloudCasaTenantProfile is the Schema for the cloudcasatenantprofiles API.
+type CloudCasaTenantProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CloudCasaTenantProfileSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// CloudCasaTenantProfileList contains a list of CloudCasaTenantProfile.
+type CloudCasaTenantProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudCasaTenantProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudCasaTenantProfile{}, &CloudCasaTenantProfileList{})
+}
@@ -0,0 +1,32 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobStatus mirrors the state of a CloudCasa job.
+type JobStatus struct {
+	// ID of the CloudCasa job, empty until the job has been scheduled.
+	ID string `json:"id,omitempty"`
+	// State of the CloudCasa job, such as PENDING, RUNNING, COMPLETED, PARTIAL, or FAILED.
+	State string `json:"state,omitempty"`
+	// Message reported by CloudCasa for the job.
+	Message string `json:"message,omitempty"`
+	// Time the job has been started at.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Time the job has been completed at.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// ID of the CloudCasa backup instance created, or restored, by the job.
+	BackupInstanceID string `json:"backupInstanceID,omitempty"`
+	// Number of Kubernetes resources processed by the job.
+	TotalResources int `json:"totalResources,omitempty"`
+	// Number of Kubernetes resources backed up, or restored, by the job.
+	Resources int `json:"resources,omitempty"`
+	// Number of errors reported by the job.
+	Errors int `json:"errors,omitempty"`
+	// Number of warnings reported by the job.
+	Warnings int `json:"warnings,omitempty"`
+}
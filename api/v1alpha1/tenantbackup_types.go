@@ -0,0 +1,65 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantBackupSpec defines the Namespaces of the Tenant to back up on demand.
+type TenantBackupSpec struct {
+	// Namespaces of the Tenant to back up, all the Tenant ones if empty.
+	// Namespaces not belonging to the Tenant are refused.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Snapshot the Persistent Volumes of the Namespaces.
+	//+kubebuilder:default=true
+	SnapshotPersistentVolumes *bool `json:"snapshotPersistentVolumes,omitempty"`
+	// Number of days the backup is retained for.
+	//+kubebuilder:default=7
+	//+kubebuilder:validation:Minimum=1
+	RetentionDays int `json:"retentionDays,omitempty"`
+}
+
+// TenantBackupStatus defines the observed state of TenantBackup.
+type TenantBackupStatus struct {
+	// Tenant the backed up Namespaces belong to.
+	Tenant string `json:"tenant,omitempty"`
+	// Namespaces included in the backup.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ID of the CloudCasa Kubebackup definition.
+	BackupID string `json:"backupID,omitempty"`
+	// CloudCasa job running the backup.
+	Job *JobStatus `json:"job,omitempty"`
+	// Conditions of the backup, such as Accepted and Completed.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=tbk
+//+kubebuilder:printcolumn:name="Tenant",type="string",JSONPath=".status.tenant",description="Tenant"
+//+kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.job.state",description="CloudCasa job state"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantBackup is the Schema for the tenantbackups API: an on-demand CloudCasa backup of the Tenant Namespaces.
+type TenantBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantBackupSpec   `json:"spec,omitempty"`
+	Status TenantBackupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TenantBackupList contains a list of TenantBackup.
+type TenantBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantBackup{}, &TenantBackupList{})
+}
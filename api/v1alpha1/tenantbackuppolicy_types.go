@@ -0,0 +1,78 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupSchedule defines when the backups are taken, and how long they are retained for.
+type BackupSchedule struct {
+	// Cron expression in the five fields format, such as "0 2 * * *".
+	//+kubebuilder:validation:Pattern=`^\S+(\s+\S+){4}$`
+	Cron string `json:"cron"`
+	// Number of days the backups taken by the schedule are retained for.
+	//+kubebuilder:default=7
+	//+kubebuilder:validation:Minimum=1
+	RetentionDays int `json:"retentionDays,omitempty"`
+}
+
+// TenantBackupPolicySpec defines the schedules the Namespaces of the Tenant are backed up with.
+type TenantBackupPolicySpec struct {
+	// Namespaces of the Tenant to back up, all the Tenant ones if empty, following the Namespaces added to,
+	// and removed from, the Tenant. Namespaces not belonging to the Tenant are excluded.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Schedules of the backups.
+	//+kubebuilder:validation:MinItems=1
+	Schedules []BackupSchedule `json:"schedules"`
+	// Timezone the schedules are evaluated in.
+	//+kubebuilder:default=UTC
+	Timezone string `json:"timezone,omitempty"`
+	// Snapshot the Persistent Volumes of the Namespaces.
+	//+kubebuilder:default=true
+	SnapshotPersistentVolumes *bool `json:"snapshotPersistentVolumes,omitempty"`
+}
+
+// TenantBackupPolicyStatus defines the observed state of TenantBackupPolicy.
+type TenantBackupPolicyStatus struct {
+	// Tenant the backed up Namespaces belong to.
+	Tenant string `json:"tenant,omitempty"`
+	// Namespaces covered by the backups.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ID of the CloudCasa Policy holding the schedules.
+	PolicyID string `json:"policyID,omitempty"`
+	// ID of the CloudCasa Kubebackup definition.
+	BackupID string `json:"backupID,omitempty"`
+	// Conditions of the policy, such as Ready.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=tbp
+//+kubebuilder:printcolumn:name="Tenant",type="string",JSONPath=".status.tenant",description="Tenant"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Ready"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantBackupPolicy is the Schema for the tenantbackuppolicies API: scheduled CloudCasa backups of the Tenant Namespaces.
+type TenantBackupPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantBackupPolicySpec   `json:"spec,omitempty"`
+	Status TenantBackupPolicyStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TenantBackupPolicyList contains a list of TenantBackupPolicy.
+type TenantBackupPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantBackupPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantBackupPolicy{}, &TenantBackupPolicyList{})
+}
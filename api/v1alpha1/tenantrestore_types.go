@@ -0,0 +1,67 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantRestoreSpec defines the CloudCasa backup to restore into the Namespaces of the Tenant.
+type TenantRestoreSpec struct {
+	// ID of the CloudCasa backup instance to restore, which must belong to the Tenant.
+	//+kubebuilder:validation:MinLength=1
+	BackupInstanceID string `json:"backupInstanceID"`
+	// Namespaces of the backup to restore, all the backed up ones if empty.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Renames the restored Namespaces, from the backed up name to the target one:
+	// the target Namespaces must exist, and belong to the Tenant.
+	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
+	// Restore the Persistent Volumes of the Namespaces.
+	//+kubebuilder:default=true
+	RestorePersistentVolumes *bool `json:"restorePersistentVolumes,omitempty"`
+}
+
+// TenantRestoreStatus defines the observed state of TenantRestore.
+type TenantRestoreStatus struct {
+	// Tenant the restored Namespaces belong to.
+	Tenant string `json:"tenant,omitempty"`
+	// Namespaces the backup is restored into.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ID of the CloudCasa Kuberestore definition.
+	RestoreID string `json:"restoreID,omitempty"`
+	// CloudCasa job running the restore.
+	Job *JobStatus `json:"job,omitempty"`
+	// Conditions of the restore, such as Accepted and Completed.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=trs
+//+kubebuilder:printcolumn:name="Tenant",type="string",JSONPath=".status.tenant",description="Tenant"
+//+kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.job.state",description="CloudCasa job state"
+//+kubebuilder:printcolumn:name="Restored",type="integer",JSONPath=".status.job.resources",description="Restored resources"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// TenantRestore is the Schema for the tenantrestores API: a CloudCasa restore into the Tenant Namespaces.
+type TenantRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantRestoreSpec   `json:"spec,omitempty"`
+	Status TenantRestoreStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TenantRestoreList contains a list of TenantRestore.
+type TenantRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TenantRestore{}, &TenantRestoreList{})
+}
@@ -0,0 +1,37 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+// The CloudCasa simulator serves an in-memory CloudCasa API, allowing to run the addon locally without a CloudCasa account.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/simulator"
+)
+
+func main() {
+	var bindAddr, token, org string
+
+	var rejectNamespaces bool
+
+	flag.StringVar(&bindAddr, "bind-address", ":8090", "The address the simulated CloudCasa API server binds to.")
+	flag.StringVar(&token, "token", "", "The bearer token required to authenticate the requests, none if empty.")
+	flag.StringVar(&org, "organization", "simulator", "The name of the Organization created at startup.")
+	flag.BoolVar(&rejectNamespaces, "reject-namespace-registration", false, "Refuse the manual registration of Kubenamespaces.")
+	flag.Parse()
+
+	s := simulator.New()
+	s.Token = token
+	s.RejectNamespaceRegistration = rejectNamespaces
+
+	s.Insert(simulator.Orgs, simulator.Document{"name": org})
+
+	log.Printf("serving the CloudCasa API simulator on %s, use the URL as --cloudcasa-api-url", bindAddr)
+
+	if err := http.ListenAndServe(bindAddr, s); err != nil {
+		log.Fatal(err)
+	}
+}
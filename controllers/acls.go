@@ -0,0 +1,225 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	goerr "github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+const (
+	// managedACLsTag is the UserGroup tag storing the ACL entries applied by the addon, used to
+	// distinguish them from the ones added by administrators, which must be preserved.
+	managedACLsTag = "capsule-clastix-io-managed-acls"
+	// maxACLUpdateAttempts is the number of UserGroup ACL updates attempted upon ETag mismatches.
+	maxACLUpdateAttempts = 5
+)
+
+// aclKey returns the canonical representation of an ACL entry, regardless of the items order.
+func aclKey(acl oapi.ACL, withResourceIDs bool) string {
+	sorted := func(items *[]string) string {
+		if items == nil {
+			return ""
+		}
+
+		s := append([]string{}, *items...)
+		sort.Strings(s)
+
+		return strings.Join(s, ",")
+	}
+
+	key := fmt.Sprintf("%s|%s|%s", acl.Resource, sorted(acl.Permissions), sorted(acl.Roles))
+	if withResourceIDs {
+		key = fmt.Sprintf("%s|%s", key, sorted(acl.ResourceIds))
+	}
+
+	return key
+}
+
+func toACLs(userGroupACLs *[]oapi.UserGroupACL) []oapi.ACL {
+	if userGroupACLs == nil {
+		return nil
+	}
+
+	acls := make([]oapi.ACL, 0, len(*userGroupACLs))
+
+	for _, acl := range *userGroupACLs {
+		var roles *[]string
+
+		if acl.Roles != nil {
+			ids := make([]string, 0, len(*acl.Roles))
+
+			for _, role := range *acl.Roles {
+				if role.Id != nil {
+					ids = append(ids, *role.Id)
+				}
+			}
+
+			roles = &ids
+		}
+
+		acls = append(acls, oapi.ACL{
+			Permissions: acl.Permissions,
+			Resource:    acl.Resource,
+			ResourceIds: acl.ResourceIds,
+			Roles:       roles,
+		})
+	}
+
+	return acls
+}
+
+// managedACLs returns the ACL entries last applied by the addon, false if they have never been tracked.
+func managedACLs(userGroup *oapi.Usergroup) ([]oapi.ACL, bool) {
+	if userGroup.Tags == nil {
+		return nil, false
+	}
+
+	v, ok := (*userGroup.Tags)[managedACLsTag].(string)
+	if !ok {
+		return nil, false
+	}
+
+	var acls []oapi.ACL
+
+	if err := json.Unmarshal([]byte(v), &acls); err != nil {
+		return nil, false
+	}
+
+	return acls, true
+}
+
+// mergeACLs performs a three-way merge between the current UserGroup ACLs, the ones last applied by the addon,
+// and the desired ones: entries not owned by the addon are preserved.
+// When the applied entries have never been tracked, the ones with the same shape of the desired entries,
+// regardless of the resource IDs, are considered as owned.
+func mergeACLs(current, lastApplied []oapi.ACL, tracked bool, desired []oapi.ACL) (merged, added, removed []oapi.ACL) {
+	owned := map[string]struct{}{}
+
+	for _, acl := range lastApplied {
+		owned[aclKey(acl, true)] = struct{}{}
+	}
+
+	shapes := map[string]struct{}{}
+
+	for _, acl := range desired {
+		shapes[aclKey(acl, false)] = struct{}{}
+	}
+
+	isOwned := func(acl oapi.ACL) (ok bool) {
+		if tracked {
+			_, ok = owned[aclKey(acl, true)]
+		} else {
+			_, ok = shapes[aclKey(acl, false)]
+		}
+
+		return ok
+	}
+
+	currentKeys := map[string]struct{}{}
+
+	for _, acl := range current {
+		currentKeys[aclKey(acl, true)] = struct{}{}
+	}
+
+	desiredKeys := map[string]struct{}{}
+
+	for _, acl := range desired {
+		desiredKeys[aclKey(acl, true)] = struct{}{}
+	}
+
+	for _, acl := range current {
+		_, isDesired := desiredKeys[aclKey(acl, true)]
+
+		switch {
+		case isDesired:
+			continue
+		case isOwned(acl):
+			removed = append(removed, acl)
+		default:
+			merged = append(merged, acl)
+		}
+	}
+
+	for _, acl := range desired {
+		if _, ok := currentKeys[aclKey(acl, true)]; !ok {
+			added = append(added, acl)
+		}
+
+		merged = append(merged, acl)
+	}
+
+	return merged, added, removed
+}
+
+func sameACLs(a, b []oapi.ACL) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	keys := map[string]struct{}{}
+
+	for _, acl := range a {
+		keys[aclKey(acl, true)] = struct{}{}
+	}
+
+	for _, acl := range b {
+		if _, ok := keys[aclKey(acl, true)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *Manager) logACLDiff(ctx context.Context, userGroup *oapi.Usergroup, added, removed []oapi.ACL) {
+	format := func(acls []oapi.ACL) string {
+		keys := make([]string, 0, len(acls))
+
+		for _, acl := range acls {
+			keys = append(keys, aclKey(acl, true))
+		}
+
+		return strings.Join(keys, "; ")
+	}
+
+	log.FromContext(ctx).Info("computed CloudCasa UserGroup ACL diff", "usergroup", *userGroup.Id, "dryRun", m.ACLDryRun, "added", format(added), "removed", format(removed))
+}
+
+// updateManagedACLs tracks the ACL entries applied by the addon in the UserGroup tags.
+func (m *Manager) updateManagedACLs(ctx context.Context, id string, acls []oapi.ACL) error {
+	value, err := json.Marshal(acls)
+	if err != nil {
+		return err
+	}
+	// The ACL update changed the UserGroup ETag, retrieving the latest one
+	etag, userGroup, err := m.retrieveUserGroupByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	tags := map[string]interface{}{}
+
+	if userGroup.Tags != nil {
+		for k, v := range *userGroup.Tags {
+			tags[k] = v
+		}
+	}
+
+	tags[managedACLsTag] = string(value)
+
+	if err = m.cloudCasa.PatchUserGroup(ctx, id, etag, oapi.Usergroup{Name: userGroup.Name, Tags: &tags}); err != nil {
+		return goerr.Wrap(err, "cannot update CloudCasa UserGroup tags")
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+func namespaceACL(ids ...string) oapi.ACL {
+	return oapi.ACL{Resource: "kubenamespaces", Permissions: &[]string{"read", "write"}, ResourceIds: &ids}
+}
+
+func aclKeys(acls []oapi.ACL) []string {
+	keys := make([]string, 0, len(acls))
+
+	for _, acl := range acls {
+		keys = append(keys, aclKey(acl, true))
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func TestMergeACLs(t *testing.T) {
+	global := oapi.ACL{Resource: "allresources", Permissions: &[]string{"read"}}
+	// manual is added by the administrators, sharing the shape of the addon ones
+	manual := namespaceACL("ns-admin")
+
+	tests := map[string]struct {
+		current     []oapi.ACL
+		lastApplied []oapi.ACL
+		tracked     bool
+		desired     []oapi.ACL
+		merged      []oapi.ACL
+		added       []oapi.ACL
+		removed     []oapi.ACL
+	}{
+		"up to date": {
+			current:     []oapi.ACL{global, namespaceACL("ns-1")},
+			lastApplied: []oapi.ACL{namespaceACL("ns-1")},
+			tracked:     true,
+			desired:     []oapi.ACL{namespaceACL("ns-1")},
+			merged:      []oapi.ACL{global, namespaceACL("ns-1")},
+		},
+		"Namespace added": {
+			current:     []oapi.ACL{global, namespaceACL("ns-1")},
+			lastApplied: []oapi.ACL{namespaceACL("ns-1")},
+			tracked:     true,
+			desired:     []oapi.ACL{namespaceACL("ns-1", "ns-2")},
+			merged:      []oapi.ACL{global, namespaceACL("ns-1", "ns-2")},
+			added:       []oapi.ACL{namespaceACL("ns-1", "ns-2")},
+			removed:     []oapi.ACL{namespaceACL("ns-1")},
+		},
+		"resource IDs order ignored": {
+			current:     []oapi.ACL{namespaceACL("ns-2", "ns-1")},
+			lastApplied: []oapi.ACL{namespaceACL("ns-2", "ns-1")},
+			tracked:     true,
+			desired:     []oapi.ACL{namespaceACL("ns-1", "ns-2")},
+			merged:      []oapi.ACL{namespaceACL("ns-1", "ns-2")},
+		},
+		"tracked preserves the administrators entries": {
+			current:     []oapi.ACL{global, manual, namespaceACL("ns-1")},
+			lastApplied: []oapi.ACL{namespaceACL("ns-1")},
+			tracked:     true,
+			desired:     []oapi.ACL{namespaceACL("ns-2")},
+			merged:      []oapi.ACL{global, manual, namespaceACL("ns-2")},
+			added:       []oapi.ACL{namespaceACL("ns-2")},
+			removed:     []oapi.ACL{namespaceACL("ns-1")},
+		},
+		"untracked owns the entries with the desired shape": {
+			current: []oapi.ACL{global, manual, namespaceACL("ns-1")},
+			desired: []oapi.ACL{namespaceACL("ns-2")},
+			merged:  []oapi.ACL{global, namespaceACL("ns-2")},
+			added:   []oapi.ACL{namespaceACL("ns-2")},
+			removed: []oapi.ACL{manual, namespaceACL("ns-1")},
+		},
+		"entries removed by the administrators restored": {
+			current:     []oapi.ACL{global},
+			lastApplied: []oapi.ACL{namespaceACL("ns-1")},
+			tracked:     true,
+			desired:     []oapi.ACL{namespaceACL("ns-1")},
+			merged:      []oapi.ACL{global, namespaceACL("ns-1")},
+			added:       []oapi.ACL{namespaceACL("ns-1")},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			merged, added, removed := mergeACLs(tc.current, tc.lastApplied, tc.tracked, tc.desired)
+
+			if expected, got := aclKeys(tc.merged), aclKeys(merged); !reflect.DeepEqual(expected, got) {
+				t.Fatalf("expected the merged ACLs %v, got %v", expected, got)
+			}
+
+			if expected, got := aclKeys(tc.added), aclKeys(added); !reflect.DeepEqual(expected, got) {
+				t.Fatalf("expected the added ACLs %v, got %v", expected, got)
+			}
+
+			if expected, got := aclKeys(tc.removed), aclKeys(removed); !reflect.DeepEqual(expected, got) {
+				t.Fatalf("expected the removed ACLs %v, got %v", expected, got)
+			}
+		})
+	}
+}
+
+func TestSameACLs(t *testing.T) {
+	tests := map[string]struct {
+		a, b     []oapi.ACL
+		expected bool
+	}{
+		"both empty": {
+			expected: true,
+		},
+		"same entries in different order": {
+			a:        []oapi.ACL{namespaceACL("ns-1"), namespaceACL("ns-2")},
+			b:        []oapi.ACL{namespaceACL("ns-2"), namespaceACL("ns-1")},
+			expected: true,
+		},
+		"same resource IDs in different order": {
+			a:        []oapi.ACL{namespaceACL("ns-1", "ns-2")},
+			b:        []oapi.ACL{namespaceACL("ns-2", "ns-1")},
+			expected: true,
+		},
+		"different resource IDs": {
+			a: []oapi.ACL{namespaceACL("ns-1")},
+			b: []oapi.ACL{namespaceACL("ns-2")},
+		},
+		"different permissions": {
+			a: []oapi.ACL{namespaceACL("ns-1")},
+			b: []oapi.ACL{{Resource: "kubenamespaces", Permissions: &[]string{"read"}, ResourceIds: &[]string{"ns-1"}}},
+		},
+		"different length": {
+			a: []oapi.ACL{namespaceACL("ns-1")},
+			b: []oapi.ACL{namespaceACL("ns-1"), namespaceACL("ns-2")},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			if got := sameACLs(tc.a, tc.b); got != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, got)
+			}
+
+			if got := sameACLs(tc.b, tc.a); got != tc.expected {
+				t.Fatalf("expected %t with swapped arguments, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+// concurrentService updates the UserGroup ACL on behalf of the administrators right before the given number of
+// addon updates, which are thus refused because of the ETag mismatch.
+type concurrentService struct {
+	*fake.Service
+
+	conflicts int
+	attempts  int
+}
+
+func (s *concurrentService) UpdateUserGroupACL(ctx context.Context, id, etag string, acls []oapi.ACL) error {
+	s.attempts++
+
+	if s.conflicts > 0 {
+		s.conflicts--
+
+		current, userGroup, err := s.Service.GetUserGroup(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		manual := oapi.ACL{Resource: "kubebackups", Permissions: &[]string{"read"}, ResourceIds: &[]string{fmt.Sprintf("backup-%d", s.attempts)}}
+
+		if err = s.Service.UpdateUserGroupACL(ctx, id, current, append(toACLs(userGroup.Acls), manual)); err != nil {
+			return err
+		}
+	}
+
+	return s.Service.UpdateUserGroupACL(ctx, id, etag, acls)
+}
+
+func TestEnsureUserGroupACLConflicts(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		conflicts int
+		failed    bool
+	}{
+		"no conflicts":         {},
+		"retried on conflicts": {conflicts: maxACLUpdateAttempts - 1},
+		"attempts exhausted":   {conflicts: maxACLUpdateAttempts, failed: true},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			service := &concurrentService{Service: fake.New(), conflicts: tc.conflicts}
+			service.AddOrg("clastix")
+
+			_, userGroup, err := service.EnsureUserGroup(ctx, "solar", []oapi.UserGroupACL{{Resource: "allresources", Permissions: &[]string{"read"}}})
+			if err != nil {
+				t.Fatalf("cannot create the UserGroup: %s", err.Error())
+			}
+
+			tenant := &capsulev1beta2.Tenant{ObjectMeta: metav1.ObjectMeta{
+				Name:        "solar",
+				Annotations: map[string]string{annotations.ClusterIDAnnotation: testClusterID},
+			}}
+
+			m := &Manager{client: newFakeClient(), cloudCasa: service, extractor: &annotations.Extractor{}}
+
+			err = m.ensureUserGroupACL(ctx, tenant, []string{"ns-1"})
+
+			switch {
+			case tc.failed && !cloudcasa.IsPreconditionFailed(err):
+				t.Fatalf("expected the ETag mismatch to be reported, got %v", err)
+			case tc.failed:
+				if service.attempts != maxACLUpdateAttempts {
+					t.Fatalf("expected %d attempts, got %d", maxACLUpdateAttempts, service.attempts)
+				}
+
+				return
+			case err != nil:
+				t.Fatalf("cannot update the UserGroup ACL: %s", err.Error())
+			}
+
+			if expected := tc.conflicts + 1; service.attempts != expected {
+				t.Fatalf("expected %d attempts, got %d", expected, service.attempts)
+			}
+
+			_, userGroup, err = service.GetUserGroup(ctx, *userGroup.Id)
+			if err != nil {
+				t.Fatalf("cannot retrieve the UserGroup: %s", err.Error())
+			}
+
+			var granted, manual int
+
+			for _, acl := range toACLs(userGroup.Acls) {
+				switch {
+				case acl.Resource == "kubebackups":
+					manual++
+				case acl.Resource == "kubenamespaces" && acl.ResourceIds != nil && reflect.DeepEqual(*acl.ResourceIds, []string{"ns-1"}):
+					granted++
+				}
+			}
+			// The ACL is computed again at each attempt, preserving the entries added concurrently
+			if granted != 1 || manual != tc.conflicts {
+				t.Fatalf("expected the Namespace to be granted along with %d concurrent entries, got %v", tc.conflicts, aclKeys(toACLs(userGroup.Acls)))
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// isManagedBackup returns true if the Kubebackup has been created by the addon for a TenantBackup, a
+// TenantBackupPolicy, or as the Tenant default backup, whose Namespaces are reconciled by their own controllers.
+func isManagedBackup(backup oapi.Kubebackup) bool {
+	if backup.Tags == nil {
+		return false
+	}
+
+	for _, tag := range []string{tenantBackupTag, tenantBackupPolicyTag, tenantDefaultBackupTag} {
+		if _, ok := (*backup.Tags)[tag]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// syncBackups updates the Namespaces of the Kubebackups tagged with the Tenant to the current Tenant ones,
+// preserving their label selector, which is scoped to the Tenant Namespaces: since any CloudCasa user can tag a
+// Kubebackup, only the ones created by the Tenant UserGroup members, or already limited to the Tenant, are updated.
+func (n *Namespace) syncBackups(ctx context.Context, tnt *capsulev1beta2.Tenant) error {
+	clusterID, ok := n.extractor.ClusterID(tnt)
+	if !ok {
+		return nil
+	}
+
+	namespaces := sets.NewString(tnt.Status.Namespaces...)
+	// An empty Namespace selection would back up the whole cluster
+	if namespaces.Len() == 0 {
+		return nil
+	}
+
+	backups, err := n.cloudCasa.ListBackups(ctx, cloudcasa.BackupFilter{ClusterID: clusterID, Tags: map[string]string{tenantTag: tnt.GetName()}})
+	if err != nil {
+		return goerr.Wrap(err, "cannot retrieve Tenant CloudCasa Kubebackups")
+	}
+
+	if len(backups) == 0 {
+		return nil
+	}
+
+	members, err := n.userGroupMembers(ctx, tnt)
+	if err != nil {
+		return err
+	}
+
+	for _, backup := range backups {
+		if isManagedBackup(backup) {
+			continue
+		}
+
+		allNamespaces := backup.Source.AllNamespaces != nil && *backup.Source.AllNamespaces
+		if !allNamespaces && backup.Source.Namespaces != nil && sets.NewString(*backup.Source.Namespaces...).Equal(namespaces) {
+			continue
+		}
+
+		owned := backup.CcUserEmail != nil && members.Has(strings.ToLower(*backup.CcUserEmail))
+		scoped := !allNamespaces && backup.Source.Namespaces != nil && len(*backup.Source.Namespaces) > 0 && len(outsideTenant(tnt, *backup.Source.Namespaces)) == 0
+
+		if !owned && !scoped {
+			log.FromContext(ctx).Info(fmt.Sprintf("skipping CloudCasa Kubebackup %s, neither created by a Tenant UserGroup member nor limited to the Tenant Namespaces", backup.Name))
+
+			continue
+		}
+
+		patch := oapi.Kubebackup{Name: backup.Name, Cluster: backup.Cluster, Source: backup.Source}
+
+		items, all := namespaces.List(), false
+		patch.Source.AllNamespaces = &all
+		patch.Source.Namespaces = &items
+
+		if err = n.cloudCasa.UpdateBackup(ctx, string(*backup.Id), patch); err != nil {
+			return goerr.Wrap(err, fmt.Sprintf("cannot update Namespaces of CloudCasa Kubebackup %s", backup.Name))
+		}
+
+		log.FromContext(ctx).Info(fmt.Sprintf("updated Namespaces of CloudCasa Kubebackup %s to the Tenant ones", backup.Name))
+	}
+
+	return nil
+}
+
+// userGroupMembers returns the lowercase emails of the Tenant UserGroup members, none if the UserGroup doesn't exist yet.
+func (n *Namespace) userGroupMembers(ctx context.Context, tnt *capsulev1beta2.Tenant) (sets.String, error) {
+	var userGroup *oapi.Usergroup
+
+	var err error
+
+	if id, ok := n.extractor.UserGroupID(tnt); ok {
+		if _, userGroup, err = n.cloudCasa.GetUserGroup(ctx, id); cloudcasa.IsNotFound(err) {
+			err = nil
+		}
+	} else {
+		_, userGroup, err = n.cloudCasa.FindUserGroup(ctx, tnt.GetName())
+	}
+
+	if err != nil {
+		return nil, goerr.Wrap(err, "cannot retrieve the Tenant UserGroup")
+	}
+
+	members := sets.NewString()
+
+	if userGroup == nil || userGroup.Users == nil || len(*userGroup.Users) == 0 {
+		return members, nil
+	}
+
+	users, err := n.cloudCasa.ListUsers(ctx, cloudcasa.UserFilter{IDs: *userGroup.Users})
+	if err != nil {
+		return nil, goerr.Wrap(err, "cannot retrieve list of CloudCasa users")
+	}
+
+	for _, user := range users {
+		members.Insert(strings.ToLower(user.Email))
+	}
+
+	return members, nil
+}
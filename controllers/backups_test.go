@@ -0,0 +1,123 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+func TestSyncBackups(t *testing.T) {
+	testCases := []struct {
+		name          string
+		email         string
+		tags          map[string]interface{}
+		clusterID     string
+		namespaces    []string
+		allNamespaces bool
+		// userGroupID annotates the Tenant with its UserGroup ID, rather than looking it up by name.
+		userGroupID bool
+		updated     bool
+	}{
+		{name: "whole cluster backup of a member", email: "alice@clastix.io", allNamespaces: true, updated: true},
+		{name: "backup of a member with a different email case", email: "Alice@Clastix.io", namespaces: []string{"gas-production"}, updated: true},
+		{name: "backup of a member of the annotated UserGroup", email: "alice@clastix.io", allNamespaces: true, userGroupID: true, updated: true},
+		{name: "backup limited to the Tenant Namespaces", email: "mallory@clastix.io", namespaces: []string{"oil-production"}, updated: true},
+		{name: "whole cluster backup of a non member", email: "mallory@clastix.io", allNamespaces: true},
+		{name: "backup of other Tenants Namespaces by a non member", email: "mallory@clastix.io", namespaces: []string{"oil-production", "gas-production"}},
+		{name: "backup without Namespaces by a non member", email: "mallory@clastix.io", namespaces: []string{}},
+		{name: "backup without creator", namespaces: []string{"gas-production"}},
+		{name: "backup of another cluster", email: "alice@clastix.io", clusterID: "62d1a0b3c5e2f1a4b7c8d9e1", allNamespaces: true},
+		{name: "backup managed by a TenantBackup", email: "alice@clastix.io", tags: map[string]interface{}{tenantBackupTag: "5f3c1e2a"}, allNamespaces: true},
+		{name: "default backup", email: "alice@clastix.io", tags: map[string]interface{}{tenantDefaultBackupTag: "true"}, namespaces: []string{"oil-production"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			service := fake.New()
+			aliceID := service.AddUser("alice@clastix.io")
+			service.AddUser("mallory@clastix.io")
+
+			_, userGroup, err := service.EnsureUserGroup(ctx, "oil", nil)
+			if err != nil {
+				t.Fatalf("cannot create UserGroup: %s", err.Error())
+			}
+
+			etag, _, _ := service.GetUserGroup(ctx, *userGroup.Id)
+			if err = service.PatchUserGroup(ctx, *userGroup.Id, etag, oapi.Usergroup{Users: &[]string{aliceID}}); err != nil {
+				t.Fatalf("cannot add the UserGroup members: %s", err.Error())
+			}
+
+			tags := map[string]interface{}{tenantTag: "oil"}
+			for k, v := range tc.tags {
+				tags[k] = v
+			}
+
+			clusterID := tc.clusterID
+			if len(clusterID) == 0 {
+				clusterID = testClusterID
+			}
+
+			backup := oapi.Kubebackup{Cluster: oapi.KubeclusterId(clusterID), Name: "nightly", Tags: &tags}
+			if len(tc.email) > 0 {
+				backup.CcUserEmail = &tc.email
+			}
+
+			backup.Source.AllNamespaces = &tc.allNamespaces
+			if tc.namespaces != nil {
+				backup.Source.Namespaces = &tc.namespaces
+			}
+
+			created, err := service.EnsureBackup(ctx, backup)
+			if err != nil {
+				t.Fatalf("cannot create CloudCasa Kubebackup: %s", err.Error())
+			}
+
+			tenant := &capsulev1beta2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "oil", Annotations: map[string]string{annotations.ClusterIDAnnotation: testClusterID}},
+				Status:     capsulev1beta2.TenantStatus{Namespaces: []string{"oil-production", "oil-development"}},
+			}
+			if tc.userGroupID {
+				// The UserGroup is renamed, being looked up by ID only
+				etag, _, _ = service.GetUserGroup(ctx, *userGroup.Id)
+				if err = service.PatchUserGroup(ctx, *userGroup.Id, etag, oapi.Usergroup{Name: "oil-renamed"}); err != nil {
+					t.Fatalf("cannot rename the UserGroup: %s", err.Error())
+				}
+
+				tenant.Annotations[annotations.UserGroupAnnotation] = *userGroup.Id
+			}
+
+			n := &Namespace{cloudCasa: service, extractor: &annotations.Extractor{}}
+
+			if err = n.syncBackups(ctx, tenant); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			current, err := service.GetBackup(ctx, string(*created.Id))
+			if err != nil {
+				t.Fatalf("cannot retrieve CloudCasa Kubebackup: %s", err.Error())
+			}
+
+			expected := backup.Source
+			if tc.updated {
+				namespaces, all := []string{"oil-development", "oil-production"}, false
+				expected.Namespaces, expected.AllNamespaces = &namespaces, &all
+			}
+
+			if !reflect.DeepEqual(current.Source.Namespaces, expected.Namespaces) || !reflect.DeepEqual(current.Source.AllNamespaces, expected.AllNamespaces) {
+				t.Errorf("expected Namespaces %v (all: %t), got %v (all: %t)", expected.Namespaces, *expected.AllNamespaces, current.Source.Namespaces, *current.Source.AllNamespaces)
+			}
+		})
+	}
+}
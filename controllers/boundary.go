@@ -0,0 +1,60 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var errNotTenantNamespace = fmt.Errorf("Namespace does not belong to any Tenant")
+
+// namespaceTenant returns the Tenant owning the given Namespace, according to the Capsule Tenant label.
+func namespaceTenant(ctx context.Context, reader client.Reader, capsuleLabel, namespace string) (*capsulev1beta2.Tenant, error) {
+	ns := &corev1.Namespace{}
+
+	if err := reader.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return nil, err
+	}
+
+	name, ok := ns.GetLabels()[capsuleLabel]
+	if !ok {
+		return nil, errNotTenantNamespace
+	}
+
+	tenant := &capsulev1beta2.Tenant{}
+
+	if err := reader.Get(ctx, types.NamespacedName{Name: name}, tenant); err != nil {
+		return nil, err
+	}
+
+	return tenant, nil
+}
+
+// isTenantNotFound returns true if the Namespace, or its Tenant, doesn't exist, or the Namespace doesn't belong to any Tenant:
+// the other errors are transient.
+func isTenantNotFound(err error) bool {
+	return errors.IsNotFound(err) || goerr.Is(err, errNotTenantNamespace)
+}
+
+// outsideTenant returns the given Namespaces not belonging to the Tenant.
+func outsideTenant(tenant *capsulev1beta2.Tenant, namespaces []string) (outside []string) {
+	owned := sets.NewString(tenant.Status.Namespaces...)
+
+	for _, namespace := range namespaces {
+		if !owned.Has(namespace) {
+			outside = append(outside, namespace)
+		}
+	}
+
+	return outside
+}
@@ -0,0 +1,163 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+const (
+	tenantFinalizer = "cloudcasa.io/cleanup"
+	tenantTag       = "capsule-clastix-io-tenant"
+)
+
+var errUserGroupNotFound = fmt.Errorf("CloudCasa UserGroup not found")
+
+// finalize removes the CloudCasa resources bound to the deleted Tenant,
+// releasing the finalizer only once the cleanup has been completed.
+func (m *Manager) finalize(ctx context.Context, tenant *capsulev1beta2.Tenant) error {
+	if !controllerutil.ContainsFinalizer(tenant, tenantFinalizer) {
+		return nil
+	}
+
+	if err := m.revokeInvitations(ctx, tenant); err != nil {
+		return goerr.Wrap(err, "cannot revoke pending CloudCasa invitations")
+	}
+
+	if err := m.cleanupUserGroup(ctx, tenant); err != nil {
+		return goerr.Wrap(err, "cannot clean up CloudCasa UserGroup")
+	}
+
+	if err := m.removeDefaultBackup(ctx, tenant); err != nil {
+		return goerr.Wrap(err, "cannot clean up CloudCasa default backup")
+	}
+
+	m.inventory.forget(tenant.GetUID())
+
+	controllerutil.RemoveFinalizer(tenant, tenantFinalizer)
+
+	return m.client.Update(ctx, tenant)
+}
+
+func (m *Manager) revokeInvitations(ctx context.Context, tenant *capsulev1beta2.Tenant) error {
+	invites, err := m.listTenantInvitations(ctx, tenant, oapi.OrginviteStatePENDING)
+	if err != nil {
+		return err
+	}
+
+	for _, invite := range invites {
+		if err = m.cloudCasa.DeleteInvite(ctx, *invite.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) cleanupUserGroup(ctx context.Context, tenant *capsulev1beta2.Tenant) error {
+	etag, userGroup, err := m.findUserGroup(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	// UserGroup has been already removed, or never created
+	if userGroup == nil {
+		return nil
+	}
+
+	if err = m.detachNamespaceACLs(ctx, etag, userGroup); err != nil {
+		return err
+	}
+
+	// The UserGroup supplied by the administrators is not owned by the addon: removing the Tenant members only
+	if _, ok := m.extractor.UserGroupID(tenant); ok {
+		log.FromContext(ctx).Info(fmt.Sprintf("retaining CloudCasa UserGroup %s supplied by the annotation %s", *userGroup.Id, annotations.UserGroupAnnotation))
+
+		return m.removeTenantMembers(ctx, tenant, *userGroup.Id)
+	}
+
+	if m.extractor.RetainUserGroup(tenant) {
+		log.FromContext(ctx).Info(fmt.Sprintf("retaining CloudCasa UserGroup %s as requested by the annotation %s", *userGroup.Id, annotations.RetainUserGroupAnnotation))
+
+		return nil
+	}
+
+	// The ACL update changed the UserGroup ETag, retrieving the latest one
+	etag, _, err = m.retrieveUserGroupByID(ctx, *userGroup.Id)
+	if err != nil {
+		return err
+	}
+
+	if err = m.cloudCasa.DeleteUserGroup(ctx, *userGroup.Id, etag); err != nil {
+		return goerr.Wrap(err, "cannot delete CloudCasa UserGroup")
+	}
+
+	return nil
+}
+
+// removeTenantMembers removes from the UserGroup the users invited on behalf of the Tenant.
+func (m *Manager) removeTenantMembers(ctx context.Context, tenant *capsulev1beta2.Tenant, id string) error {
+	invited, err := m.invitedEmails(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	// The ACL update changed the UserGroup ETag, retrieving the latest one
+	etag, userGroup, err := m.retrieveUserGroupByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return m.removeUserGroupUsers(ctx, etag, userGroup, func(email string) bool {
+		_, ok := invited[strings.ToLower(email)]
+
+		return ok
+	})
+}
+
+// detachNamespaceACLs drops the Cluster and Namespace scoped ACLs applied by the addon from the UserGroup,
+// preserving the global ones and the ones added by administrators.
+func (m *Manager) detachNamespaceACLs(ctx context.Context, etag string, userGroup *oapi.Usergroup) error {
+	if userGroup.Acls == nil {
+		return nil
+	}
+
+	owned := map[string]struct{}{}
+
+	lastApplied, tracked := managedACLs(userGroup)
+	for _, acl := range lastApplied {
+		owned[aclKey(acl, true)] = struct{}{}
+	}
+
+	current := toACLs(userGroup.Acls)
+	acls := make([]oapi.ACL, 0, len(current))
+
+	for _, acl := range current {
+		if acl.Resource == "kubeclusters" || acl.Resource == "kubenamespaces" {
+			if _, ok := owned[aclKey(acl, true)]; ok || !tracked {
+				continue
+			}
+		}
+
+		acls = append(acls, acl)
+	}
+
+	if len(acls) == len(current) {
+		return nil
+	}
+
+	if err := m.cloudCasa.UpdateUserGroupACL(ctx, *userGroup.Id, etag, acls); err != nil {
+		return fmt.Errorf("cannot detach Namespace ACLs from UserGroup : %w", err)
+	}
+
+	return nil
+}
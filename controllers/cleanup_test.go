@@ -0,0 +1,144 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// seedUserGroup creates the UserGroup with the given members, returning its ID:
+// the invited ones have an accepted invitation sent on behalf of the Tenant, the others have been added manually.
+func seedUserGroup(ctx context.Context, t *testing.T, service *fake.Service, tenant string, invited, manual []string) string {
+	t.Helper()
+
+	_, userGroup, err := service.EnsureUserGroup(ctx, tenant, []oapi.UserGroupACL{{Resource: "allresources"}})
+	if err != nil {
+		t.Fatalf("cannot create the UserGroup: %s", err.Error())
+	}
+
+	users := make([]string, 0, len(invited)+len(manual))
+
+	for _, email := range invited {
+		invite := oapi.Orginvite{Email: email, Tags: &map[string]interface{}{tenantTag: tenant}}
+		if err = service.CreateInvite(ctx, invite); err != nil {
+			t.Fatalf("cannot invite %s: %s", email, err.Error())
+		}
+
+		service.SetInviteState(email, oapi.OrginviteStateACCEPTED)
+
+		users = append(users, service.AddUser(email))
+	}
+
+	for _, email := range manual {
+		users = append(users, service.AddUser(email))
+	}
+
+	etag, _, err := service.GetUserGroup(ctx, *userGroup.Id)
+	if err != nil {
+		t.Fatalf("cannot retrieve the UserGroup: %s", err.Error())
+	}
+
+	if err = service.PatchUserGroup(ctx, *userGroup.Id, etag, oapi.Usergroup{Name: tenant, Users: &users}); err != nil {
+		t.Fatalf("cannot add the UserGroup members: %s", err.Error())
+	}
+
+	return *userGroup.Id
+}
+
+// memberEmails returns the emails of the UserGroup members, nil if the UserGroup doesn't exist.
+func memberEmails(ctx context.Context, t *testing.T, service *fake.Service, id string) []string {
+	t.Helper()
+
+	_, userGroup, err := service.GetUserGroup(ctx, id)
+	if err != nil {
+		return nil
+	}
+
+	emails := []string{}
+
+	if userGroup.Users == nil {
+		return emails
+	}
+
+	users, err := service.ListUsers(ctx, cloudcasa.UserFilter{IDs: *userGroup.Users})
+	if err != nil {
+		t.Fatalf("cannot retrieve the UserGroup members: %s", err.Error())
+	}
+
+	for _, user := range users {
+		emails = append(emails, user.Email)
+	}
+
+	return emails
+}
+
+func TestCleanupUserGroup(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		annotations func(id string) map[string]string
+		deleted     bool
+		members     []string
+	}{
+		"created by the addon": {
+			annotations: func(string) map[string]string { return nil },
+			deleted:     true,
+		},
+		"retained by the annotation": {
+			annotations: func(string) map[string]string {
+				return map[string]string{annotations.RetainUserGroupAnnotation: "true"}
+			},
+			members: []string{"alice@clastix.io", "bob@clastix.io"},
+		},
+		"supplied by the annotation": {
+			annotations: func(id string) map[string]string {
+				return map[string]string{annotations.UserGroupAnnotation: id}
+			},
+			members: []string{"bob@clastix.io"},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			service := fake.New()
+
+			id := seedUserGroup(ctx, t, service, "solar", []string{"alice@clastix.io"}, []string{"bob@clastix.io"})
+
+			tenant := &capsulev1beta2.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "solar", Annotations: tc.annotations(id)}}
+
+			m := &Manager{cloudCasa: service, extractor: &annotations.Extractor{}}
+
+			if err := m.cleanupUserGroup(ctx, tenant); err != nil {
+				t.Fatalf("cannot clean up the UserGroup: %s", err.Error())
+			}
+
+			members := memberEmails(ctx, t, service, id)
+
+			switch {
+			case tc.deleted && members != nil:
+				t.Fatalf("expected the UserGroup to be deleted, got members %v", members)
+			case tc.deleted:
+				return
+			case members == nil:
+				t.Fatal("expected the UserGroup to be retained")
+			}
+
+			if !sets.NewString(members...).Equal(sets.NewString(tc.members...)) {
+				t.Fatalf("expected the members %v, got %v", tc.members, members)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+)
+
+const (
+	UserGroupReadyCondition   = "CloudCasaUserGroupReady"
+	InvitesSentCondition      = "CloudCasaInvitesSent"
+	NamespacesSyncedCondition = "CloudCasaNamespacesSynced"
+	ACLAppliedCondition       = "CloudCasaACLApplied"
+	DefaultBackupCondition    = "CloudCasaDefaultBackupReady"
+)
+
+// tenantStatus collects the CloudCasa conditions computed during a reconciliation:
+// since the Tenant status is owned by Capsule, these are persisted in the annotation
+// annotations.StatusAnnotation.
+type tenantStatus struct {
+	generation int64
+	previous   []metav1.Condition
+	conditions []metav1.Condition
+}
+
+func newTenantStatus(tenant *capsulev1beta2.Tenant, previous []metav1.Condition) *tenantStatus {
+	conditions := make([]metav1.Condition, len(previous))
+	copy(conditions, previous)
+
+	return &tenantStatus{
+		generation: tenant.GetGeneration(),
+		previous:   previous,
+		conditions: conditions,
+	}
+}
+
+func (s *tenantStatus) setTrue(conditionType, reason, message string) {
+	s.set(conditionType, metav1.ConditionTrue, reason, message)
+}
+
+func (s *tenantStatus) setFalse(conditionType, reason string, err error) {
+	s.set(conditionType, metav1.ConditionFalse, reason, err.Error())
+}
+
+func (s *tenantStatus) set(conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&s.conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: s.generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+func (s *tenantStatus) remove(conditionType string) {
+	meta.RemoveStatusCondition(&s.conditions, conditionType)
+}
+
+// transitions returns the conditions which changed their status or reason
+// in comparison to the ones of the previous reconciliation.
+func (s *tenantStatus) transitions() (transitions []metav1.Condition) {
+	for _, condition := range s.conditions {
+		previous := meta.FindStatusCondition(s.previous, condition.Type)
+		if previous != nil && previous.Status == condition.Status && previous.Reason == condition.Reason {
+			continue
+		}
+
+		transitions = append(transitions, condition)
+	}
+
+	return transitions
+}
+
+func (m *Manager) updateStatus(ctx context.Context, tenant *capsulev1beta2.Tenant, status *tenantStatus) error {
+	for _, condition := range status.transitions() {
+		eventType := corev1.EventTypeNormal
+		if condition.Status != metav1.ConditionTrue {
+			eventType = corev1.EventTypeWarning
+		}
+
+		m.recorder.Event(tenant, eventType, condition.Reason, condition.Message)
+	}
+
+	if equality.Semantic.DeepEqual(status.previous, status.conditions) {
+		return nil
+	}
+
+	value, err := json.Marshal(status.conditions)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(tenant.DeepCopy())
+
+	tntAnnotations := tenant.GetAnnotations()
+	if tntAnnotations == nil {
+		tntAnnotations = map[string]string{}
+	}
+
+	tntAnnotations[annotations.StatusAnnotation] = string(value)
+	tenant.SetAnnotations(tntAnnotations)
+
+	return m.client.Patch(ctx, tenant, patch)
+}
+
+// isStatusUpdate returns true when the Tenant update is just about the CloudCasa status annotation,
+// allowing to skip the reconciliation triggered by the Manager itself.
+func isStatusUpdate(oldObj, newObj client.Object) bool {
+	oldObj, newObj = oldObj.DeepCopyObject().(client.Object), newObj.DeepCopyObject().(client.Object)
+
+	for _, obj := range []client.Object{oldObj, newObj} {
+		obj.SetResourceVersion("")
+		obj.SetManagedFields(nil)
+
+		if tntAnnotations := obj.GetAnnotations(); tntAnnotations != nil {
+			delete(tntAnnotations, annotations.StatusAnnotation)
+			obj.SetAnnotations(tntAnnotations)
+		}
+	}
+
+	return equality.Semantic.DeepEqual(oldObj, newObj)
+}
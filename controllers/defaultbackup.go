@@ -0,0 +1,99 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// tenantDefaultBackupTag marks the Policy and Kubebackup created as the Tenant default backup.
+const tenantDefaultBackupTag = "capsule-clastix-io-default-backup"
+
+// ensureDefaultBackup schedules the default backups of the Tenant Namespaces, removing the previously
+// created ones when the Tenant opted out: only the CloudCasa API errors are returned.
+func (m *Manager) ensureDefaultBackup(ctx context.Context, tenant *capsulev1beta2.Tenant, status *tenantStatus) error {
+	schedule, ok := m.extractor.BackupSchedule(tenant)
+	if !ok {
+		// The tagged Kubebackups are looked up, rather than relying on the condition, which may have been lost
+		if err := m.removeDefaultBackup(ctx, tenant); err != nil {
+			status.setFalse(DefaultBackupCondition, "DefaultBackupNotRemoved", err)
+
+			return err
+		}
+
+		status.remove(DefaultBackupCondition)
+
+		return nil
+	}
+
+	if _, err := cronFields(schedule.Cron); err != nil {
+		status.setFalse(DefaultBackupCondition, "InvalidDefaultBackupSchedule", fmt.Errorf("invalid default backup schedule: %w", err))
+
+		return nil
+	}
+
+	clusterID, _ := m.extractor.ClusterID(tenant)
+
+	_, _, err := ensureScheduledBackup(ctx, m.cloudCasa, scheduledBackup{
+		name:      cloudCasaName(tenant.GetName(), "default-backup", string(tenant.GetUID())),
+		clusterID: clusterID,
+		tags: map[string]interface{}{
+			tenantTag:              tenant.GetName(),
+			tenantDefaultBackupTag: "true",
+		},
+		namespaces: tenant.Status.Namespaces,
+		timezone:   schedule.Timezone,
+		schedules:  []cloudcasav1alpha1.BackupSchedule{{Cron: schedule.Cron, RetentionDays: schedule.RetentionDays}},
+	})
+	if err != nil {
+		status.setFalse(DefaultBackupCondition, "DefaultBackupNotScheduled", err)
+
+		return err
+	}
+
+	if len(tenant.Status.Namespaces) == 0 {
+		status.setTrue(DefaultBackupCondition, "DefaultBackupPaused", "CloudCasa default backup is paused until the Tenant has Namespaces")
+
+		return nil
+	}
+
+	status.setTrue(DefaultBackupCondition, "DefaultBackupScheduled", fmt.Sprintf("CloudCasa default backup is scheduled at %q, retained for %d days", schedule.Cron, schedule.RetentionDays))
+
+	return nil
+}
+
+// removeDefaultBackup deletes the Kubebackups created as the Tenant default backup, along with their Policy.
+func (m *Manager) removeDefaultBackup(ctx context.Context, tenant *capsulev1beta2.Tenant) error {
+	clusterID, _ := m.extractor.ClusterID(tenant)
+
+	backups, err := m.cloudCasa.ListBackups(ctx, cloudcasa.BackupFilter{ClusterID: clusterID, Tags: map[string]string{tenantTag: tenant.GetName(), tenantDefaultBackupTag: "true"}})
+	if err != nil {
+		return goerr.Wrap(err, "cannot retrieve CloudCasa default backups")
+	}
+
+	for _, backup := range backups {
+		var policyID oapi.PolicyId
+
+		if backup.Policy != nil {
+			policyID = *backup.Policy
+		}
+
+		if err = deleteScheduledBackup(ctx, m.cloudCasa, string(policyID), string(*backup.Id)); err != nil {
+			return err
+		}
+
+		log.FromContext(ctx).Info(fmt.Sprintf("removed CloudCasa default backup %s", backup.Name))
+	}
+
+	return nil
+}
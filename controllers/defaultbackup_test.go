@@ -0,0 +1,175 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+)
+
+func TestEnsureDefaultBackup(t *testing.T) {
+	defaultSchedule := annotations.BackupSchedule{Cron: "0 2 * * *", RetentionDays: 7, Timezone: "UTC"}
+
+	testCases := []struct {
+		name              string
+		tenantAnnotations map[string]string
+		namespaces        []string
+		// scheduled creates the default backup before the tested reconciliation.
+		scheduled bool
+		// conditionLost discards the conditions of the reconciliation scheduling the default backup.
+		conditionLost bool
+		// reason of the DefaultBackup condition, removed if empty.
+		reason  string
+		backups int
+	}{
+		{name: "scheduled", namespaces: []string{"oil-production"}, reason: "DefaultBackupScheduled", backups: 1},
+		{name: "paused without Namespaces", reason: "DefaultBackupPaused", backups: 1},
+		{
+			name:              "invalid schedule",
+			tenantAnnotations: map[string]string{annotations.DefaultBackupScheduleAnnotation: "@daily"},
+			namespaces:        []string{"oil-production"},
+			reason:            "InvalidDefaultBackupSchedule",
+		},
+		{
+			name:              "opted out",
+			tenantAnnotations: map[string]string{annotations.DefaultBackupAnnotation: "false"},
+			namespaces:        []string{"oil-production"},
+		},
+		{
+			name:              "opted out after being scheduled",
+			tenantAnnotations: map[string]string{annotations.DefaultBackupAnnotation: "false"},
+			namespaces:        []string{"oil-production"},
+			scheduled:         true,
+		},
+		{
+			name:              "opted out after losing the condition",
+			tenantAnnotations: map[string]string{annotations.DefaultBackupAnnotation: "false"},
+			namespaces:        []string{"oil-production"},
+			scheduled:         true,
+			conditionLost:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tenant := &capsulev1beta2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "oil", UID: "oil-uid", Annotations: map[string]string{annotations.ClusterIDAnnotation: testClusterID}},
+				Status:     capsulev1beta2.TenantStatus{Namespaces: tc.namespaces},
+			}
+
+			service := fake.New()
+			m := &Manager{cloudCasa: service, extractor: &annotations.Extractor{DefaultBackupSchedule: defaultSchedule}}
+
+			var previous []metav1.Condition
+
+			if tc.scheduled {
+				status := newTenantStatus(tenant, nil)
+				if err := m.ensureDefaultBackup(ctx, tenant, status); err != nil {
+					t.Fatalf("cannot schedule the default backup: %s", err.Error())
+				}
+
+				if !tc.conditionLost {
+					previous = status.conditions
+				}
+			}
+
+			for k, v := range tc.tenantAnnotations {
+				tenant.Annotations[k] = v
+			}
+
+			status := newTenantStatus(tenant, previous)
+			if err := m.ensureDefaultBackup(ctx, tenant, status); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			condition := meta.FindStatusCondition(status.conditions, DefaultBackupCondition)
+
+			switch {
+			case len(tc.reason) == 0 && condition != nil:
+				t.Errorf("expected the DefaultBackup condition to be removed, got %+v", condition)
+			case len(tc.reason) > 0 && (condition == nil || condition.Reason != tc.reason):
+				t.Errorf("expected the DefaultBackup condition with reason %s, got %+v", tc.reason, condition)
+			}
+
+			backups, err := service.ListBackups(ctx, cloudcasa.BackupFilter{ClusterID: testClusterID, Tags: map[string]string{tenantTag: "oil", tenantDefaultBackupTag: "true"}})
+			if err != nil {
+				t.Fatalf("cannot retrieve CloudCasa Kubebackups: %s", err.Error())
+			}
+
+			if len(backups) != tc.backups {
+				t.Fatalf("expected %d default backups, got %d", tc.backups, len(backups))
+			}
+
+			for _, backup := range backups {
+				paused := backup.Pause != nil && *backup.Pause
+				if paused != (len(tc.namespaces) == 0) {
+					t.Errorf("expected the default backup pause to be %t", len(tc.namespaces) == 0)
+				}
+			}
+		})
+	}
+}
+
+func TestManagerDefaultBackupFailure(t *testing.T) {
+	ctx := context.Background()
+
+	objects := tenantObjects(t, "oil", map[string]string{annotations.ClusterIDAnnotation: testClusterID}, "oil-production")
+	tenant := objects[0]
+
+	service := &faultyService{
+		Service:         fake.New(),
+		ensurePolicyErr: &cloudcasa.APIError{StatusCode: http.StatusBadGateway, Code: http.StatusBadGateway, Message: "bad gateway"},
+	}
+	service.AddOrg("clastix")
+
+	m := &Manager{
+		InventoryTimeout:  time.Minute,
+		client:            newFakeClient(objects...),
+		recorder:          record.NewFakeRecorder(100),
+		cloudCasa:         service,
+		extractor:         &annotations.Extractor{DefaultBackupSchedule: annotations.BackupSchedule{Cron: "0 2 * * *", RetentionDays: 7}},
+		invitationBackoff: flowcontrol.NewBackOff(time.Second, time.Minute),
+		inventory:         newNamespaceInventory(),
+	}
+
+	result, err := m.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tenant)})
+	if err == nil {
+		t.Fatal("expected the default backup error to be returned")
+	}
+	// The Namespace missing in CloudCasa is checked again, regardless of the default backup failure
+	if result.RequeueAfter != namespaceInitialBackoff {
+		t.Errorf("expected the Namespaces inventory to be checked again after %s, got %s", namespaceInitialBackoff, result.RequeueAfter)
+	}
+
+	current := &capsulev1beta2.Tenant{}
+	if err = m.client.Get(ctx, client.ObjectKeyFromObject(tenant), current); err != nil {
+		t.Fatalf("cannot retrieve Tenant: %s", err.Error())
+	}
+
+	conditions := m.extractor.Conditions(current)
+	// The ACL has been applied regardless of the default backup failure
+	if !meta.IsStatusConditionTrue(conditions, ACLAppliedCondition) {
+		t.Errorf("expected the ACL to be reported as applied, got %+v", meta.FindStatusCondition(conditions, ACLAppliedCondition))
+	}
+
+	if condition := meta.FindStatusCondition(conditions, DefaultBackupCondition); condition == nil || condition.Reason != "DefaultBackupNotScheduled" {
+		t.Errorf("expected the default backup to be reported as not scheduled, got %+v", condition)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	namespaceInitialBackoff = 10 * time.Second
+	namespaceMaxBackoff     = 5 * time.Minute
+)
+
+// namespaceInventory tracks the Namespaces still not discovered by CloudCasa, delaying the checks of each one
+// with an exponential backoff, and keeping the time they have been found missing for the first time.
+type namespaceInventory struct {
+	backoff *flowcontrol.Backoff
+	mutex   sync.Mutex
+	// since is indexed by the Tenant UID, and then by the cluster ID and Namespace name.
+	since map[types.UID]map[string]time.Time
+}
+
+func newNamespaceInventory() *namespaceInventory {
+	return &namespaceInventory{
+		backoff: flowcontrol.NewBackOff(namespaceInitialBackoff, namespaceMaxBackoff),
+		since:   map[types.UID]map[string]time.Time{},
+	}
+}
+
+// sync records the given Namespaces of the Tenant as not inventoried, returning the delay after which these must be
+// checked again, along with the ones missing for longer than the given timeout: the previously missing Namespaces
+// are no more tracked, since these have been discovered by CloudCasa, or removed from the Tenant.
+func (i *namespaceInventory) sync(tenant types.UID, clusterID string, missing []string, timeout time.Duration) (requeueAfter time.Duration, expired []string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	now, previous, current := i.backoff.Clock.Now(), i.since[tenant], make(map[string]time.Time, len(missing))
+
+	for _, namespace := range missing {
+		key := clusterID + "/" + namespace
+
+		since, ok := previous[key]
+		if !ok {
+			since = now
+		}
+
+		current[key] = since
+
+		if timeout > 0 && now.Sub(since) >= timeout {
+			expired = append(expired, namespace)
+		}
+
+		if !i.backoff.IsInBackOffSinceUpdate(key, now) {
+			i.backoff.Next(key, now)
+		}
+
+		if delay := i.backoff.Get(key); requeueAfter == 0 || delay < requeueAfter {
+			requeueAfter = delay
+		}
+	}
+
+	for key := range previous {
+		if _, ok := current[key]; !ok {
+			i.backoff.Reset(key)
+		}
+	}
+
+	if len(current) == 0 {
+		delete(i.since, tenant)
+	} else {
+		i.since[tenant] = current
+	}
+
+	return requeueAfter, expired
+}
+
+// forget stops tracking the Namespaces of the deleted Tenant.
+func (i *namespaceInventory) forget(tenant types.UID) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for key := range i.since[tenant] {
+		i.backoff.Reset(key)
+	}
+
+	delete(i.since, tenant)
+}
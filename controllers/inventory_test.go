@@ -0,0 +1,100 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func newFakeInventory() (*namespaceInventory, *testingclock.FakeClock) {
+	clock := testingclock.NewFakeClock(time.Now())
+
+	return &namespaceInventory{
+		backoff: flowcontrol.NewFakeBackOff(namespaceInitialBackoff, namespaceMaxBackoff, clock),
+		since:   map[types.UID]map[string]time.Time{},
+	}, clock
+}
+
+func TestNamespaceInventorySync(t *testing.T) {
+	const timeout = 3 * namespaceInitialBackoff
+
+	inventory, clock := newFakeInventory()
+
+	steps := []struct {
+		advance      time.Duration
+		missing      []string
+		requeueAfter time.Duration
+		expired      []string
+	}{
+		{missing: []string{"ns-1", "ns-2"}, requeueAfter: namespaceInitialBackoff},
+		{advance: namespaceInitialBackoff / 2, missing: []string{"ns-1", "ns-2"}, requeueAfter: namespaceInitialBackoff},
+		// ns-2 has been discovered, no more tracked
+		{advance: namespaceInitialBackoff / 2, missing: []string{"ns-1"}, requeueAfter: 2 * namespaceInitialBackoff},
+		// ns-2 is missing again, tracked from scratch
+		{advance: 2 * namespaceInitialBackoff, missing: []string{"ns-1", "ns-2"}, requeueAfter: namespaceInitialBackoff, expired: []string{"ns-1"}},
+		{advance: namespaceInitialBackoff, missing: []string{}},
+	}
+
+	for i, step := range steps {
+		clock.Step(step.advance)
+
+		requeueAfter, expired := inventory.sync("solar-uid", testClusterID, step.missing, timeout)
+
+		if requeueAfter != step.requeueAfter {
+			t.Fatalf("step %d: expected to check again after %s, got %s", i, step.requeueAfter, requeueAfter)
+		}
+
+		if !reflect.DeepEqual(expired, step.expired) {
+			t.Fatalf("step %d: expected the expired Namespaces %v, got %v", i, step.expired, expired)
+		}
+	}
+
+	if _, ok := inventory.since["solar-uid"]; ok {
+		t.Fatal("expected the Tenant to be no more tracked once all the Namespaces have been discovered")
+	}
+}
+
+func TestNamespaceInventoryTenants(t *testing.T) {
+	const timeout = namespaceInitialBackoff
+
+	inventory, clock := newFakeInventory()
+
+	inventory.sync("solar-uid", testClusterID, []string{"ns-1"}, timeout)
+	inventory.sync("oil-uid", testClusterID, []string{"oil-1"}, timeout)
+
+	clock.Step(timeout)
+
+	if _, expired := inventory.sync("oil-uid", testClusterID, []string{"oil-1"}, timeout); !reflect.DeepEqual(expired, []string{"oil-1"}) {
+		t.Fatalf("expected the oil Namespace to be expired, got %v", expired)
+	}
+	// The deleted Tenant Namespaces are tracked from scratch if missing again
+	inventory.forget("solar-uid")
+
+	requeueAfter, expired := inventory.sync("solar-uid", testClusterID, []string{"ns-1"}, timeout)
+	if len(expired) > 0 || requeueAfter != namespaceInitialBackoff {
+		t.Fatalf("expected the forgotten Namespace to be tracked from scratch, got expired %v after %s", expired, requeueAfter)
+	}
+
+	if _, ok := inventory.since["oil-uid"]; !ok {
+		t.Fatal("expected the other Tenant to be still tracked")
+	}
+}
+
+func TestNamespaceInventoryNoTimeout(t *testing.T) {
+	inventory, clock := newFakeInventory()
+
+	for i := 0; i < 10; i++ {
+		if _, expired := inventory.sync("solar-uid", testClusterID, []string{"ns-1"}, 0); len(expired) > 0 {
+			t.Fatalf("expected no expiration with no timeout, got %v", expired)
+		}
+
+		clock.Step(namespaceMaxBackoff)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+const (
+	invitationInitialBackoff = time.Minute
+	invitationMaxBackoff     = 24 * time.Hour
+)
+
+var errInvitationDeclined = fmt.Errorf("CloudCasa invitation has been declined")
+
+// reinvite sends again the CloudCasa invitation to the owner according to the Tenant invitation policy:
+// subsequent attempts are delayed with an exponential backoff, returning the duration to wait for.
+func (m *Manager) reinvite(ctx context.Context, tenant *capsulev1beta2.Tenant, invitation *oapi.Orginvite, userGroupID string) (time.Duration, error) {
+	policy := m.extractor.InvitationPolicy(tenant)
+
+	switch {
+	case *invitation.State == oapi.OrginviteStateDECLINED && policy != annotations.InvitationPolicyResendAll:
+		return 0, errInvitationDeclined
+	case policy == annotations.InvitationPolicyNever:
+		return 0, fmt.Errorf("CloudCasa invitation is %s and the Tenant invitation policy is %s", strings.ToLower(string(*invitation.State)), policy)
+	}
+
+	m.invitationBackoff.GC()
+
+	id, now := fmt.Sprintf("%s/%s", tenant.GetName(), invitation.Email), m.invitationBackoff.Clock.Now()
+
+	if m.invitationBackoff.IsInBackOffSinceUpdate(id, now) {
+		return m.invitationBackoff.Get(id), nil
+	}
+
+	m.invitationBackoff.Next(id, now)
+
+	log.FromContext(ctx).Info(fmt.Sprintf("sending again %s CloudCasa invitation to %s", strings.ToLower(string(*invitation.State)), invitation.Email))
+
+	if err := m.cloudCasa.DeleteInvite(ctx, *invitation.Id); err != nil {
+		return 0, err
+	}
+
+	if err := m.createInvitation(ctx, invitation.Email, userGroupID, tenant.GetName()); err != nil {
+		return 0, err
+	}
+
+	return m.invitationBackoff.Get(id), nil
+}
+
+// ensureUserGroupMember adds the user who accepted the invitation to the Tenant UserGroup, if missing.
+func (m *Manager) ensureUserGroupMember(ctx context.Context, email, etag string, userGroup *oapi.Usergroup) error {
+	items, err := m.cloudCasa.ListUsers(ctx, cloudcasa.UserFilter{Email: email})
+	if err != nil {
+		return goerr.Wrap(err, "cannot retrieve CloudCasa user")
+	}
+
+	if len(items) == 0 {
+		return fmt.Errorf("CloudCasa invitation has been accepted but the user %s does not exist", email)
+	}
+
+	id := string(*items[0].Id)
+
+	var users []string
+
+	if userGroup.Users != nil {
+		users = *userGroup.Users
+	}
+
+	for _, user := range users {
+		if user == id {
+			return nil
+		}
+	}
+
+	log.FromContext(ctx).Info(fmt.Sprintf("adding user %s to CloudCasa UserGroup, missing although the invitation has been accepted", email))
+
+	return m.updateUserGroupUsers(ctx, etag, userGroup, append(users, id))
+}
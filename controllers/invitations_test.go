@@ -0,0 +1,176 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/flowcontrol"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// sentInvitation returns the invitation sent to the given email, failing if there's not exactly one.
+func sentInvitation(ctx context.Context, t *testing.T, service *fake.Service, email string) oapi.Orginvite {
+	t.Helper()
+
+	invites, err := service.ListInvites(ctx, cloudcasa.InviteFilter{Email: email})
+	if err != nil {
+		t.Fatalf("cannot list the invitations: %s", err.Error())
+	}
+
+	if len(invites) != 1 {
+		t.Fatalf("expected one invitation for %s, got %d", email, len(invites))
+	}
+
+	return invites[0]
+}
+
+func TestReinvite(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		policy   annotations.InvitationPolicy
+		state    oapi.OrginviteState
+		resent   bool
+		declined bool
+	}{
+		"expired with default policy": {
+			state:  oapi.OrginviteStateEXPIRED,
+			resent: true,
+		},
+		"declined with default policy": {
+			state:    oapi.OrginviteStateDECLINED,
+			declined: true,
+		},
+		"expired with ResendAll policy": {
+			policy: annotations.InvitationPolicyResendAll,
+			state:  oapi.OrginviteStateEXPIRED,
+			resent: true,
+		},
+		"declined with ResendAll policy": {
+			policy: annotations.InvitationPolicyResendAll,
+			state:  oapi.OrginviteStateDECLINED,
+			resent: true,
+		},
+		"expired with Never policy": {
+			policy: annotations.InvitationPolicyNever,
+			state:  oapi.OrginviteStateEXPIRED,
+		},
+		"declined with Never policy": {
+			policy:   annotations.InvitationPolicyNever,
+			state:    oapi.OrginviteStateDECLINED,
+			declined: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			service := fake.New()
+
+			if err := service.CreateInvite(ctx, oapi.Orginvite{Email: "alice@clastix.io", Tags: &map[string]interface{}{tenantTag: "solar"}}); err != nil {
+				t.Fatalf("cannot create the invitation: %s", err.Error())
+			}
+
+			service.SetInviteState("alice@clastix.io", tc.state)
+
+			tenant := &capsulev1beta2.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "solar"}}
+			if len(tc.policy) > 0 {
+				tenant.SetAnnotations(map[string]string{annotations.InvitationPolicyAnnotation: string(tc.policy)})
+			}
+
+			m := &Manager{
+				cloudCasa:         service,
+				extractor:         &annotations.Extractor{},
+				invitationBackoff: flowcontrol.NewBackOff(invitationInitialBackoff, invitationMaxBackoff),
+			}
+
+			invitation := sentInvitation(ctx, t, service, "alice@clastix.io")
+
+			requeueAfter, err := m.reinvite(ctx, tenant, &invitation, "usergroup")
+
+			switch {
+			case tc.declined && !goerr.Is(err, errInvitationDeclined):
+				t.Fatalf("expected the declined invitation to be reported, got %v", err)
+			case !tc.declined && !tc.resent && err == nil:
+				t.Fatal("expected the invitation policy to be reported")
+			case tc.resent && err != nil:
+				t.Fatalf("cannot send again the invitation: %s", err.Error())
+			}
+
+			current := sentInvitation(ctx, t, service, "alice@clastix.io")
+
+			if resent := *current.Id != *invitation.Id; resent != tc.resent {
+				t.Fatalf("expected the invitation to be sent again: %t, got %t", tc.resent, resent)
+			}
+
+			if tc.resent && requeueAfter != invitationInitialBackoff {
+				t.Fatalf("expected to check again the invitation after %s, got %s", invitationInitialBackoff, requeueAfter)
+			}
+		})
+	}
+}
+
+func TestReinviteBackoff(t *testing.T) {
+	ctx := context.Background()
+
+	service := fake.New()
+	clock := testingclock.NewFakeClock(time.Now())
+
+	m := &Manager{
+		cloudCasa:         service,
+		extractor:         &annotations.Extractor{},
+		invitationBackoff: flowcontrol.NewFakeBackOff(invitationInitialBackoff, invitationMaxBackoff, clock),
+	}
+
+	tenant := &capsulev1beta2.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "solar"}}
+
+	if err := service.CreateInvite(ctx, oapi.Orginvite{Email: "alice@clastix.io", Tags: &map[string]interface{}{tenantTag: "solar"}}); err != nil {
+		t.Fatalf("cannot create the invitation: %s", err.Error())
+	}
+
+	steps := []struct {
+		advance      time.Duration
+		resent       bool
+		requeueAfter time.Duration
+	}{
+		{resent: true, requeueAfter: invitationInitialBackoff},
+		{advance: invitationInitialBackoff / 2, requeueAfter: invitationInitialBackoff},
+		{advance: invitationInitialBackoff / 2, resent: true, requeueAfter: 2 * invitationInitialBackoff},
+		{advance: invitationInitialBackoff, requeueAfter: 2 * invitationInitialBackoff},
+		{advance: invitationInitialBackoff, resent: true, requeueAfter: 4 * invitationInitialBackoff},
+	}
+
+	for i, step := range steps {
+		clock.Step(step.advance)
+
+		service.SetInviteState("alice@clastix.io", oapi.OrginviteStateEXPIRED)
+
+		invitation := sentInvitation(ctx, t, service, "alice@clastix.io")
+
+		requeueAfter, err := m.reinvite(ctx, tenant, &invitation, "usergroup")
+		if err != nil {
+			t.Fatalf("step %d: cannot send again the invitation: %s", i, err.Error())
+		}
+
+		if resent := *sentInvitation(ctx, t, service, "alice@clastix.io").Id != *invitation.Id; resent != step.resent {
+			t.Fatalf("step %d: expected the invitation to be sent again: %t, got %t", i, step.resent, resent)
+		}
+
+		if requeueAfter != step.requeueAfter {
+			t.Fatalf("step %d: expected to check again the invitation after %s, got %s", i, step.requeueAfter, requeueAfter)
+		}
+	}
+}
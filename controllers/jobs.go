@@ -0,0 +1,97 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// jobPollInterval is the delay between the checks of a running CloudCasa job.
+const jobPollInterval = 30 * time.Second
+
+// isJobFinished returns true when the CloudCasa job state will not change anymore.
+func isJobFinished(state string) bool {
+	switch oapi.JobState(state) {
+	case oapi.JobStateCOMPLETED, oapi.JobStatePARTIAL, oapi.JobStateFAILED, oapi.JobStateSKIPPED, oapi.JobStateCANCELED:
+		return true
+	default:
+		return false
+	}
+}
+
+// isJobSucceeded returns true when the CloudCasa job has been completed: a PARTIAL job skipped some resources,
+// and it's thus reported as not completed, with the Partial reason.
+func isJobSucceeded(state string) bool {
+	return oapi.JobState(state) == oapi.JobStateCOMPLETED
+}
+
+// jobReason returns the condition reason for the CloudCasa job state, such as Completed for COMPLETED.
+func jobReason(state string) string {
+	if len(state) == 0 {
+		return "Unknown"
+	}
+
+	return strings.ToUpper(state[:1]) + strings.ToLower(state[1:])
+}
+
+// jobStatus mirrors the CloudCasa job, the resources being the ones backed up, or restored, according to the job type.
+func jobStatus(job *oapi.Job) *cloudcasav1alpha1.JobStatus {
+	intValue := func(v *int) int {
+		if v == nil {
+			return 0
+		}
+
+		return *v
+	}
+
+	timeValue := func(v *int) *metav1.Time {
+		if v == nil || *v == 0 {
+			return nil
+		}
+
+		t := metav1.NewTime(time.Unix(int64(*v), 0))
+
+		return &t
+	}
+
+	status := &cloudcasav1alpha1.JobStatus{
+		StartTime:      timeValue(job.StartTime),
+		TotalResources: intValue(job.NumTotalResources),
+		Resources:      intValue(job.NumBackupResources),
+		Errors:         intValue(job.NumErrors),
+		Warnings:       intValue(job.NumWarnings),
+	}
+
+	if job.Id != nil {
+		status.ID = string(*job.Id)
+	}
+
+	if job.BackupInst != nil {
+		status.BackupInstanceID = string(*job.BackupInst)
+	}
+
+	if job.State != nil {
+		status.State = string(*job.State)
+	}
+
+	if job.Message != nil {
+		status.Message = *job.Message
+	}
+
+	if job.Type == oapi.JobTypeRESTORE {
+		status.Resources = intValue(job.NumRestoredResources)
+	}
+
+	if isJobFinished(status.State) {
+		status.CompletionTime = timeValue(job.EndTime)
+	}
+
+	return status
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+func TestJobStates(t *testing.T) {
+	tests := map[oapi.JobState]struct {
+		finished, succeeded bool
+		reason              string
+	}{
+		oapi.JobStatePENDING:    {reason: "Pending"},
+		oapi.JobStateRUNNING:    {reason: "Running"},
+		oapi.JobStateCANCELLING: {reason: "Cancelling"},
+		oapi.JobStateCOMPLETED:  {finished: true, succeeded: true, reason: "Completed"},
+		oapi.JobStatePARTIAL:    {finished: true, reason: "Partial"},
+		oapi.JobStateFAILED:     {finished: true, reason: "Failed"},
+		oapi.JobStateSKIPPED:    {finished: true, reason: "Skipped"},
+		oapi.JobStateCANCELED:   {finished: true, reason: "Canceled"},
+		"":                      {reason: "Unknown"},
+	}
+
+	for state, tc := range tests {
+		if got := isJobFinished(string(state)); got != tc.finished {
+			t.Fatalf("%q: expected finished %t, got %t", state, tc.finished, got)
+		}
+
+		if got := isJobSucceeded(string(state)); got != tc.succeeded {
+			t.Fatalf("%q: expected succeeded %t, got %t", state, tc.succeeded, got)
+		}
+
+		if got := jobReason(string(state)); got != tc.reason {
+			t.Fatalf("%q: expected the reason %s, got %s", state, tc.reason, got)
+		}
+	}
+}
+
+func TestJobStatus(t *testing.T) {
+	intPtr := func(i int) *int {
+		return &i
+	}
+
+	id, backupInstance, message := oapi.JobId("job-1"), oapi.BackupinstanceId("instance-1"), "done"
+	start, end := time.Date(2022, 7, 15, 10, 0, 0, 0, time.UTC), time.Date(2022, 7, 15, 10, 5, 0, 0, time.UTC)
+
+	job := oapi.Job{
+		Id:                   &id,
+		BackupInst:           &backupInstance,
+		Message:              &message,
+		Type:                 oapi.JobTypeK8SSNAP,
+		StartTime:            intPtr(int(start.Unix())),
+		EndTime:              intPtr(int(end.Unix())),
+		NumTotalResources:    intPtr(12),
+		NumBackupResources:   intPtr(10),
+		NumRestoredResources: intPtr(8),
+		NumErrors:            intPtr(1),
+		NumWarnings:          intPtr(2),
+	}
+
+	tests := map[string]struct {
+		state      oapi.JobState
+		jobType    oapi.JobType
+		resources  int
+		completion bool
+	}{
+		"running backup":   {state: oapi.JobStateRUNNING, jobType: oapi.JobTypeK8SSNAP, resources: 10},
+		"completed backup": {state: oapi.JobStateCOMPLETED, jobType: oapi.JobTypeK8SSNAP, resources: 10, completion: true},
+		"partial restore":  {state: oapi.JobStatePARTIAL, jobType: oapi.JobTypeRESTORE, resources: 8, completion: true},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			job := job
+			job.State, job.Type = &tc.state, tc.jobType
+
+			status := jobStatus(&job)
+
+			if status.ID != "job-1" || status.BackupInstanceID != "instance-1" || status.State != string(tc.state) || status.Message != message {
+				t.Fatalf("expected the job to be mirrored, got %+v", status)
+			}
+
+			if status.TotalResources != 12 || status.Resources != tc.resources || status.Errors != 1 || status.Warnings != 2 {
+				t.Fatalf("expected the job counters to be mirrored, got %+v", status)
+			}
+
+			if status.StartTime == nil || !status.StartTime.Time.Equal(start) {
+				t.Fatalf("expected the start time %s, got %v", start, status.StartTime)
+			}
+
+			switch {
+			case tc.completion && (status.CompletionTime == nil || !status.CompletionTime.Time.Equal(end)):
+				t.Fatalf("expected the completion time %s, got %v", end, status.CompletionTime)
+			case !tc.completion && status.CompletionTime != nil:
+				t.Fatalf("expected no completion time while the job is running, got %v", status.CompletionTime)
+			}
+		})
+	}
+
+	if status := jobStatus(&oapi.Job{StartTime: intPtr(0)}); status.StartTime != nil || len(status.ID) > 0 {
+		t.Fatalf("expected the missing fields to be zero, got %+v", status)
+	}
+}
@@ -6,44 +6,65 @@ package controllers
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"strings"
+	"time"
 
 	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
 	goerr "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
 	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
-	cloudcasa "github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/transport"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/groups"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/metrics"
 )
 
 type Manager struct {
-	client    client.Client
-	cloudCasa *cloudcasa.ClientWithResponses
-	extractor annotations.Annotations
+	GroupResolver groups.Resolver
+	ACLDryRun     bool
+	// InventoryTimeout is the time after which a Tenant Namespace still not discovered by CloudCasa
+	// is reported as not inventoried.
+	InventoryTimeout time.Duration
+	// Breaker pauses the reconciliation when the CloudCasa API is unavailable, if set.
+	Breaker *transport.Breaker
+	// DefaultClusterID is the CloudCasa cluster ID of the Tenants without the cluster ID annotation, if any.
+	DefaultClusterID string
+	// DefaultBackupSchedule is the schedule of the default backups of the Tenants, none if the cron expression is empty.
+	DefaultBackupSchedule annotations.BackupSchedule
+
+	client            client.Client
+	recorder          record.EventRecorder
+	cloudCasa         cloudcasa.Service
+	extractor         annotations.Annotations
+	invitationBackoff *flowcontrol.Backoff
+	inventory         *namespaceInventory
 }
 
-func (m *Manager) SetupWithManager(serverURL, token string, mgr manager.Manager) error {
-	cc, err := cloudcasa.NewClientWithResponses(serverURL, cloudcasa.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-
-		return nil
-	}))
-	if err != nil {
-		return err
-	}
-
+func (m *Manager) SetupWithManager(cc cloudcasa.Service, mgr manager.Manager) error {
 	m.cloudCasa = cc
-	m.extractor = &annotations.Extractor{}
+	m.extractor = &annotations.Extractor{DefaultClusterID: m.DefaultClusterID, DefaultBackupSchedule: m.DefaultBackupSchedule}
+	m.recorder = mgr.GetEventRecorderFor("capsule-addon-cloudcasa")
+	m.invitationBackoff = flowcontrol.NewBackOff(invitationInitialBackoff, invitationMaxBackoff)
+	m.inventory = newNamespaceInventory()
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&capsulev1beta2.Tenant{}, builder.WithPredicates(predicate.Funcs{
@@ -56,6 +77,14 @@ func (m *Manager) SetupWithManager(serverURL, token string, mgr manager.Manager)
 				return true
 			},
 			UpdateFunc: func(updateEvent event.UpdateEvent) (ok bool) {
+				if isStatusUpdate(updateEvent.ObjectOld, updateEvent.ObjectNew) {
+					return false
+				}
+
+				if controllerutil.ContainsFinalizer(updateEvent.ObjectNew, tenantFinalizer) {
+					return true
+				}
+
 				_, ok = m.extractor.ClusterID(updateEvent.ObjectNew)
 				if !ok {
 					return false
@@ -64,6 +93,7 @@ func (m *Manager) SetupWithManager(serverURL, token string, mgr manager.Manager)
 				return ok
 			},
 		})).
+		Watches(&source.Kind{Type: &cloudcasav1alpha1.CloudCasaTenantProfile{}}, handler.EnqueueRequestsFromMapFunc(m.enqueueProfileTenants)).
 		Complete(m)
 }
 
@@ -78,6 +108,12 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (rec
 
 	logger.Info("Starting reconciliation")
 
+	if wait := m.Breaker.OpenFor(); wait > 0 {
+		logger.Info("CloudCasa API is unavailable, pausing reconciliation", "requeueAfter", wait)
+
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
 	tenant := &capsulev1beta2.Tenant{}
 
 	if err := m.client.Get(ctx, request.NamespacedName, tenant); err != nil {
@@ -90,90 +126,151 @@ func (m *Manager) Reconcile(ctx context.Context, request reconcile.Request) (rec
 		return reconcile.Result{}, err
 	}
 
-	if err := m.ensureUserGroup(ctx, tenant); err != nil {
-		logger.Error(err, "CloudCasa UserGroup for the given tenant does not exist")
+	if !tenant.GetDeletionTimestamp().IsZero() {
+		if err := m.finalize(ctx, tenant); err != nil {
+			logger.Error(err, "cannot clean up CloudCasa resources for the given tenant")
 
-		return reconcile.Result{}, err
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{}, nil
 	}
 
-	for _, owner := range tenant.Spec.Owners {
-		if err := m.ensureUser(ctx, owner, tenant); err != nil {
-			logger.Error(err, fmt.Sprintf("failed ensuring user %s for Tenant %s", owner.Name, tenant.GetName()))
+	if !controllerutil.ContainsFinalizer(tenant, tenantFinalizer) {
+		controllerutil.AddFinalizer(tenant, tenantFinalizer)
+
+		if err := m.client.Update(ctx, tenant); err != nil {
+			logger.Error(err, "cannot add the CloudCasa finalizer to the given tenant")
+
+			return reconcile.Result{}, err
 		}
 	}
 
-	if err := m.ensureKubernetesNamespaces(ctx, tenant); err != nil {
+	status := newTenantStatus(tenant, m.extractor.Conditions(tenant))
+
+	defer func() {
+		if err := m.updateStatus(ctx, tenant, status); err != nil {
+			logger.Error(err, "cannot update the CloudCasa status of the given tenant")
+		}
+	}()
+
+	if err := m.ensureUserGroup(ctx, tenant); err != nil {
+		logger.Error(err, "CloudCasa UserGroup for the given tenant does not exist")
+
+		status.setFalse(UserGroupReadyCondition, "UserGroupNotReady", err)
+
 		return reconcile.Result{}, err
 	}
 
-	return reconcile.Result{}, nil
-}
-
-func (m *Manager) ensureUser(ctx context.Context, owner capsulev1beta2.OwnerSpec, tenant *capsulev1beta2.Tenant) error {
-	_, userGroup, err := m.retrieveUserGroup(ctx, tenant)
+	status.setTrue(UserGroupReadyCondition, "UserGroupReady", "CloudCasa UserGroup is available")
 
-	email := m.extractor.OwnerEmail(tenant, owner)
+	result := reconcile.Result{RequeueAfter: m.ensureOwners(ctx, tenant, status)}
 
-	invitationStatus, err := m.getUserInvitation(ctx, email)
+	ids, missing, mismatched, err := m.ensureKubernetesNamespaces(ctx, tenant)
 	if err != nil {
-		return err
+		status.setFalse(NamespacesSyncedCondition, "NamespacesSyncFailed", err)
+
+		return reconcile.Result{}, err
+	}
+	// Namespaces are discovered by the CloudCasa agent asynchronously,
+	// checking again the missing ones until these are available.
+	clusterID, _ := m.extractor.ClusterID(tenant)
+
+	namespacesRequeueAfter, expired := m.inventory.sync(tenant.GetUID(), clusterID, missing, m.InventoryTimeout)
+	if namespacesRequeueAfter > 0 && (result.RequeueAfter == 0 || namespacesRequeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = namespacesRequeueAfter
 	}
 
 	switch {
-	case invitationStatus == nil:
-		err = m.createInvitation(ctx, email, *userGroup.Id, tenant.GetName())
+	case len(mismatched) > 0:
+		status.setFalse(NamespacesSyncedCondition, "NamespacesUIDMismatch", fmt.Errorf("CloudCasa Namespaces refer to a different Namespace UID, these are not granted: %s", strings.Join(mismatched, ", ")))
+	case len(expired) > 0:
+		status.setFalse(NamespacesSyncedCondition, "NamespacesNotInventoried", fmt.Errorf("Namespaces not inventoried by CloudCasa after %s: %s", m.InventoryTimeout, strings.Join(expired, ", ")))
+	case len(missing) > 0:
+		status.setFalse(NamespacesSyncedCondition, "NamespacesPending", fmt.Errorf("Namespaces still not present in CloudCasa, checking again in %s: %s", namespacesRequeueAfter, strings.Join(missing, ", ")))
 	default:
-		err = nil
+		status.setTrue(NamespacesSyncedCondition, "NamespacesSynced", "all Tenant Namespaces are present in CloudCasa")
 	}
 
-	return err
+	if err = m.ensureUserGroupACL(ctx, tenant, ids); err != nil {
+		status.setFalse(ACLAppliedCondition, "ACLNotApplied", err)
+
+		return reconcile.Result{}, err
+	}
+
+	if m.ACLDryRun {
+		status.set(ACLAppliedCondition, metav1.ConditionUnknown, "ACLDryRun", "CloudCasa UserGroup ACL diff has been computed but not applied, dry-run mode is enabled")
+	} else {
+		status.setTrue(ACLAppliedCondition, "ACLApplied", "CloudCasa UserGroup ACL has been applied")
+	}
+	// The default backup failures are reported in its own condition, the ACL having been applied anyway
+	if err = m.ensureDefaultBackup(ctx, tenant, status); err != nil {
+		return result, err
+	}
+
+	return result, nil
 }
 
-func (m *Manager) getUserInvitation(ctx context.Context, email string) (*cloudcasa.OrginviteState, error) {
-	where := cloudcasa.QueryWhere(fmt.Sprintf(`{"email": %q}`, email))
+func (m *Manager) ensureUser(ctx context.Context, email string, tenant *capsulev1beta2.Tenant) (time.Duration, error) {
+	etag, userGroup, err := m.retrieveUserGroup(ctx, tenant)
+	if err != nil {
+		return 0, err
+	}
 
-	res, err := m.cloudCasa.Getv1orginvitesWithResponse(ctx, &cloudcasa.Getv1orginvitesParams{Where: &where})
+	invitation, err := m.getUserInvitation(ctx, email)
 	if err != nil {
-		return nil, goerr.Wrap(err, "cannot retrieve list of OrgInvites for the current user")
+		return 0, err
 	}
 
-	if resErr := res.JSONDefault; resErr != nil {
-		return nil, m.formatCloudCasaError(resErr)
+	switch {
+	case invitation == nil:
+		return 0, m.createInvitation(ctx, email, *userGroup.Id, tenant.GetName())
+	case invitation.State == nil:
+		return 0, nil
+	case *invitation.State == oapi.OrginviteStateACCEPTED:
+		return 0, m.ensureUserGroupMember(ctx, email, etag, userGroup)
+	case *invitation.State == oapi.OrginviteStateEXPIRED, *invitation.State == oapi.OrginviteStateDECLINED:
+		return m.reinvite(ctx, tenant, invitation, *userGroup.Id)
+	default:
+		return 0, nil
 	}
+}
 
-	items := res.JSON200.Items
+func (m *Manager) getUserInvitation(ctx context.Context, email string) (*oapi.Orginvite, error) {
+	items, err := m.cloudCasa.ListInvites(ctx, cloudcasa.InviteFilter{Email: email})
+	if err != nil {
+		return nil, goerr.Wrap(err, "cannot retrieve list of OrgInvites for the current user")
+	}
 
 	switch {
-	case len(*items) == 0:
+	case len(items) == 0:
 		return nil, nil
-	case len(*items) == 1:
-		return (*items)[0].State, nil
+	case len(items) == 1:
+		return &items[0], nil
 	}
 
 	return nil, fmt.Errorf("cannot retrieve OrgInvite for the current user, multiple entries")
 }
 
-func (m *Manager) formatCloudCasaError(err *cloudcasa.Error) error {
-	return fmt.Errorf(fmt.Sprintf("%s (%d)", *err.Error.Message, *err.Error.Code))
-}
-
-func (m *Manager) retrieveUserGroupByID(ctx context.Context, id string) (string, *cloudcasa.Usergroup, error) {
-	res, err := m.cloudCasa.GetUsergroupItemWithResponse(ctx, cloudcasa.UsergroupId(id))
+func (m *Manager) listTenantInvitations(ctx context.Context, tenant *capsulev1beta2.Tenant, state oapi.OrginviteState) ([]oapi.Orginvite, error) {
+	items, err := m.cloudCasa.ListInvites(ctx, cloudcasa.InviteFilter{State: state, Tags: map[string]string{tenantTag: tenant.GetName()}})
 	if err != nil {
-		return "", nil, goerr.Wrap(err, "cannot create request for CloudCasa UserGroup retrieval")
+		return nil, goerr.Wrap(err, "cannot retrieve list of OrgInvites for the current Tenant")
 	}
 
-	switch {
-	case res.JSON200 != nil:
-		return res.HTTPResponse.Header.Get("etag"), res.JSON200, nil
-	case res.JSONDefault != nil:
-		return "", nil, m.formatCloudCasaError(res.JSONDefault)
-	default:
-		return "", nil, fmt.Errorf("unhandled error for CloudCasa UserGroup retrieval")
+	return items, nil
+}
+
+func (m *Manager) retrieveUserGroupByID(ctx context.Context, id string) (string, *oapi.Usergroup, error) {
+	etag, userGroup, err := m.cloudCasa.GetUserGroup(ctx, id)
+	if cloudcasa.IsNotFound(err) {
+		return "", nil, errUserGroupNotFound
 	}
+
+	return etag, userGroup, err
 }
 
-func (m *Manager) retrieveUserGroup(ctx context.Context, tenant *capsulev1beta2.Tenant) (etag string, userGroup *cloudcasa.Usergroup, err error) {
+func (m *Manager) retrieveUserGroup(ctx context.Context, tenant *capsulev1beta2.Tenant) (etag string, userGroup *oapi.Usergroup, err error) {
 	id, ok := m.extractor.UserGroupID(tenant)
 	if ok {
 		return m.retrieveUserGroupByID(ctx, id)
@@ -182,58 +279,63 @@ func (m *Manager) retrieveUserGroup(ctx context.Context, tenant *capsulev1beta2.
 	return m.retrieveUserGroupFromAPI(ctx, tenant)
 }
 
-func (m *Manager) retrieveUserGroupFromAPI(ctx context.Context, tenant *capsulev1beta2.Tenant) (string, *cloudcasa.Usergroup, error) {
-	where := cloudcasa.QueryWhere(fmt.Sprintf(`{"name": %q}`, tenant.GetName()))
-
-	res, err := m.cloudCasa.Getv1usergroupsWithResponse(ctx, &cloudcasa.Getv1usergroupsParams{Where: &where})
+func (m *Manager) retrieveUserGroupFromAPI(ctx context.Context, tenant *capsulev1beta2.Tenant) (string, *oapi.Usergroup, error) {
+	etag, userGroup, err := m.lookupUserGroup(ctx, tenant)
 	if err != nil {
 		return "", nil, err
 	}
 
-	switch {
-	case res.JSONDefault != nil:
-		return "", nil, m.formatCloudCasaError(res.JSONDefault)
-	case res.JSON200 != nil && len(*(res.JSON200).Items) > 1:
-		return "", nil, fmt.Errorf("multiple UserGroup with the same Tenant Name, force one using the annotation %s", annotations.OrganizationAnnotation)
-	case res.JSON200 != nil && len(*(res.JSON200).Items) == 1:
-		userGroup := (*res.JSON200.Items)[0]
-
-		return m.retrieveUserGroupByID(ctx, *userGroup.Id)
-	case res.JSON200 != nil && len(*(res.JSON200).Items) == 0:
+	if userGroup == nil {
 		return m.createUserGroup(ctx, tenant)
-	default:
-		return "", nil, fmt.Errorf("unhandled condition for UserGroup retrieval")
 	}
+
+	return etag, userGroup, nil
 }
 
-func (m *Manager) createUserGroup(ctx context.Context, tenant *capsulev1beta2.Tenant) (string, *cloudcasa.Usergroup, error) {
-	res, err := m.cloudCasa.Postv1usergroupsWithResponse(ctx, cloudcasa.Postv1usergroupsJSONRequestBody{
-		Id: nil,
-		Acls: &[]cloudcasa.UserGroupACL{
-			{
-				Permissions: &[]string{
-					"policies.create",
-					"kubebackups.create",
-					"kuberestores.create",
-					"kubeclusters.backup",
-					"kubeclusters.restore",
-				},
-				Resource: "allresources",
-			},
-		},
-		Name:  tenant.GetName(),
-		Tags:  nil,
-		Users: nil,
-	})
+// findUserGroup returns the UserGroup of the given Tenant without creating it when missing:
+// a nil UserGroup is returned if it doesn't exist on CloudCasa.
+func (m *Manager) findUserGroup(ctx context.Context, tenant *capsulev1beta2.Tenant) (string, *oapi.Usergroup, error) {
+	id, ok := m.extractor.UserGroupID(tenant)
+	if !ok {
+		return m.lookupUserGroup(ctx, tenant)
+	}
+
+	etag, userGroup, err := m.retrieveUserGroupByID(ctx, id)
+	if goerr.Is(err, errUserGroupNotFound) {
+		return "", nil, nil
+	}
+
+	return etag, userGroup, err
+}
+
+func (m *Manager) lookupUserGroup(ctx context.Context, tenant *capsulev1beta2.Tenant) (string, *oapi.Usergroup, error) {
+	etag, userGroup, err := m.cloudCasa.FindUserGroup(ctx, tenant.GetName())
+	if err != nil {
+		return "", nil, goerr.Wrap(err, fmt.Sprintf("cannot retrieve the Tenant UserGroup, force one using the annotation %s", annotations.UserGroupAnnotation))
+	}
+
+	return etag, userGroup, nil
+}
+
+func (m *Manager) createUserGroup(ctx context.Context, tenant *capsulev1beta2.Tenant) (string, *oapi.Usergroup, error) {
+	profile, err := m.tenantProfile(ctx, tenant)
 	if err != nil {
 		return "", nil, err
 	}
 
-	if resErr := res.JSONDefault; err != nil {
-		return "", nil, m.formatCloudCasaError(resErr)
+	clusterID, _ := m.extractor.ClusterID(tenant)
+
+	var acls []oapi.UserGroupACL
+
+	for _, acl := range renderACLs(profile, clusterID, []string{}) {
+		acls = append(acls, oapi.UserGroupACL{
+			Permissions: acl.Permissions,
+			Resource:    acl.Resource,
+			ResourceIds: acl.ResourceIds,
+		})
 	}
 
-	return m.retrieveUserGroupFromAPI(ctx, tenant)
+	return m.cloudCasa.EnsureUserGroup(ctx, tenant.GetName(), acls)
 }
 
 func (m *Manager) ensureUserGroup(ctx context.Context, tenant *capsulev1beta2.Tenant) error {
@@ -250,7 +352,7 @@ func (m *Manager) ensureUserGroup(ctx context.Context, tenant *capsulev1beta2.Te
 }
 
 func (m *Manager) createInvitation(ctx context.Context, email, userGroupID, tenant string) error {
-	res, err := m.cloudCasa.Postv1orginvitesWithResponse(ctx, cloudcasa.Postv1orginvitesJSONRequestBody{
+	err := m.cloudCasa.CreateInvite(ctx, oapi.Orginvite{
 		Acls: &[]struct {
 			Permissions *[]string `json:"permissions,omitempty"`
 			Resource    string    `json:"resource"`
@@ -270,7 +372,7 @@ func (m *Manager) createInvitation(ctx context.Context, email, userGroupID, tena
 		LastName:  tenant,
 		Name:      email,
 		Tags: &map[string]interface{}{
-			"capsule-clastix-io-tenant": tenant,
+			tenantTag: tenant,
 		},
 		Usergroups: &[]string{
 			userGroupID,
@@ -280,45 +382,63 @@ func (m *Manager) createInvitation(ctx context.Context, email, userGroupID, tena
 		return goerr.Wrap(err, "cannot create CloudCasa invitation for user")
 	}
 
-	if jsonErr := res.JSONDefault; jsonErr.Status != "OK" {
-		return m.formatCloudCasaError(jsonErr)
-	}
-
 	return nil
 }
 
-func (m *Manager) ensureKubernetesNamespaces(ctx context.Context, tenant *capsulev1beta2.Tenant) error {
+// ensureKubernetesNamespaces returns the CloudCasa IDs of the Tenant Namespaces, along with the names of the ones
+// not yet available in CloudCasa, and of the ones whose Kubenamespace refers to a different Namespace UID:
+// the latter are not granted, since these could belong to a former Namespace with the same name.
+func (m *Manager) ensureKubernetesNamespaces(ctx context.Context, tenant *capsulev1beta2.Tenant) (ids, missing, mismatched []string, err error) {
 	clusterID, ok := m.extractor.ClusterID(tenant)
 	if !ok {
-		return fmt.Errorf("missing CloudCasa Cluster ID annotation")
+		return nil, nil, nil, fmt.Errorf("missing CloudCasa Cluster ID annotation")
 	}
 
-	ids := []string{}
+	namespaces := make([]*corev1.Namespace, 0, len(tenant.Status.Namespaces))
+	names := make([]string, 0, len(tenant.Status.Namespaces))
 
 	for _, namespace := range tenant.Status.Namespaces {
 		ns := &corev1.Namespace{}
 
-		if err := m.client.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
-			return goerr.Wrap(err, "cannot retrieve Namespace for CloudCasa")
-		}
-
-		id, err := m.ensureKubernetesNamespace(ctx, ns.GetName())
-		if err != nil {
-			log.FromContext(ctx).Error(err, "cannot ensure Tenant Namespaces in CloudCasa")
-
-			continue
+		if err = m.client.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+			return nil, nil, nil, goerr.Wrap(err, "cannot retrieve Namespace for CloudCasa")
 		}
 
-		ids = append(ids, id)
+		namespaces = append(namespaces, ns)
+		names = append(names, ns.GetName())
 	}
 
-	etag, userGroup, err := m.retrieveUserGroup(ctx, tenant)
+	resolved, err := m.cloudCasa.ResolveNamespaces(ctx, clusterID, names)
 	if err != nil {
-		return goerr.Wrap(err, "cannot retrieve CloudCasa UserGroup for update")
+		return nil, nil, nil, goerr.Wrap(err, "cannot resolve Tenant Namespaces in CloudCasa")
 	}
 
-	if len(*userGroup.Acls) == 0 {
-		return fmt.Errorf("no ACLs for the current user group")
+	ids = make([]string, 0, len(names))
+
+	for _, ns := range namespaces {
+		kubeNamespace, found := resolved[ns.GetName()]
+
+		switch {
+		case !found || kubeNamespace.Id == nil:
+			log.FromContext(ctx).Info(fmt.Sprintf("Tenant Namespace %s still not present in CloudCasa", ns.GetName()))
+
+			missing = append(missing, ns.GetName())
+		case kubeNamespace.K8sUid != nil && *kubeNamespace.K8sUid != string(ns.GetUID()):
+			log.FromContext(ctx).Info(fmt.Sprintf("CloudCasa Kubenamespace %s refers to a different Namespace, expected UID %s, got %s", ns.GetName(), ns.GetUID(), *kubeNamespace.K8sUid))
+
+			mismatched = append(mismatched, ns.GetName())
+		default:
+			ids = append(ids, *kubeNamespace.Id)
+		}
+	}
+
+	return ids, missing, mismatched, nil
+}
+
+func (m *Manager) ensureUserGroupACL(ctx context.Context, tenant *capsulev1beta2.Tenant, ids []string) (err error) {
+	clusterID, ok := m.extractor.ClusterID(tenant)
+	if !ok {
+		return fmt.Errorf("missing CloudCasa Cluster ID annotation")
 	}
 
 	organizationID := m.extractor.OrganizationID(tenant)
@@ -329,91 +449,73 @@ func (m *Manager) ensureKubernetesNamespaces(ctx context.Context, tenant *capsul
 		}
 	}
 
-	res, err := m.cloudCasa.UpdateUserGroupACLWithResponse(ctx, cloudcasa.UsergroupId(*userGroup.Id), &cloudcasa.UpdateUserGroupACLParams{IfMatch: cloudcasa.IfMatch(etag)}, cloudcasa.UpdateUserGroupACLJSONRequestBody{
-		Acls: &[]cloudcasa.ACL{
-			{
-				Permissions: &[]string{
-					"policies.create",
-					"kubebackups.create",
-					"kuberestores.create",
-				},
-				Resource: "allresources",
-			},
-			{
-				Permissions: &[]string{
-					"kubeclusters.backup",
-					"kubeclusters.restore",
-				},
-				Resource:    "kubeclusters",
-				ResourceIds: &[]string{clusterID},
-			},
-			{
-				Permissions: &[]string{"kubenamespaces.read"},
-				Resource:    "kubenamespaces",
-				ResourceIds: &ids,
-			},
-		},
-	})
+	profile, err := m.tenantProfile(ctx, tenant)
 	if err != nil {
-		return fmt.Errorf("cannot update UserGroup ACL : %w", err)
+		return err
 	}
 
-	if res.JSON200 == nil {
-		return fmt.Errorf("expected successful operation from UserGroup ACL")
-	}
+	desired := renderACLs(profile, clusterID, ids)
+	// The UserGroup could be updated concurrently by administrators, or other reconciliations:
+	// upon an ETag mismatch the UserGroup is retrieved again, and the ACL computed from scratch.
+	for attempt := 1; ; attempt++ {
+		err = m.applyUserGroupACL(ctx, tenant, desired)
+		if !cloudcasa.IsPreconditionFailed(err) {
+			return err
+		}
 
-	return nil
-}
+		metrics.UserGroupACLConflicts.WithLabelValues(tenant.GetName()).Inc()
 
-func (m *Manager) retrieveKubernetesNamespace(ctx context.Context, name string) (id string, err error) {
-	where := cloudcasa.QueryWhere(fmt.Sprintf(`{"name": %q}`, name))
+		if attempt == maxACLUpdateAttempts {
+			return fmt.Errorf("cannot update UserGroup ACL after %d attempts : %w", attempt, err)
+		}
 
-	res, err := m.cloudCasa.Getv1kubenamespacesWithResponse(ctx, &cloudcasa.Getv1kubenamespacesParams{
-		Where: &where,
-	})
+		log.FromContext(ctx).V(1).Info("CloudCasa UserGroup has been updated concurrently, retrying", "attempt", attempt)
+	}
+}
+
+func (m *Manager) applyUserGroupACL(ctx context.Context, tenant *capsulev1beta2.Tenant, desired []oapi.ACL) error {
+	etag, userGroup, err := m.retrieveUserGroup(ctx, tenant)
 	if err != nil {
-		return "", goerr.Wrap(err, "cannot create request for Kubernetes Namespace retrieval on CloudCasa")
+		return goerr.Wrap(err, "cannot retrieve CloudCasa UserGroup for update")
 	}
 
-	if jsonErr := res.JSONDefault; jsonErr != nil {
-		return "", m.formatCloudCasaError(jsonErr)
+	if len(*userGroup.Acls) == 0 {
+		return fmt.Errorf("no ACLs for the current user group")
 	}
 
-	items := *res.JSON200.Items
+	lastApplied, tracked := managedACLs(userGroup)
 
-	if len(items) == 0 {
-		return "", nil
+	acls, added, removed := mergeACLs(toACLs(userGroup.Acls), lastApplied, tracked, desired)
+	if len(added) == 0 && len(removed) == 0 && tracked && sameACLs(lastApplied, desired) {
+		return nil
 	}
 
-	return *items[0].Id, nil
-}
+	m.logACLDiff(ctx, userGroup, added, removed)
 
-func (m *Manager) ensureKubernetesNamespace(ctx context.Context, name string) (id string, err error) {
-	id, err = m.retrieveKubernetesNamespace(ctx, name)
-	if err != nil {
-		return "", err
+	if m.ACLDryRun {
+		return nil
 	}
-	// Kubernetes Namespace exists on CloudCasa
-	if len(id) > 0 {
-		return id, nil
+
+	if len(added) > 0 || len(removed) > 0 {
+		if err = m.cloudCasa.UpdateUserGroupACL(ctx, *userGroup.Id, etag, acls); err != nil {
+			return fmt.Errorf("cannot update UserGroup ACL : %w", err)
+		}
 	}
-	return "", fmt.Errorf("Kubernetes Namespace still not present in CloudCasa, enquing back the request")
+
+	return m.updateManagedACLs(ctx, *userGroup.Id, desired)
 }
 
 func (m *Manager) retrieveFirstOrganizationID(ctx context.Context) (string, error) {
-	res, err := m.cloudCasa.Getv1orgsWithResponse(ctx, &cloudcasa.Getv1orgsParams{})
+	items, err := m.cloudCasa.ListOrgs(ctx)
 	if err != nil {
 		return "", err
 	}
 
-	response := res.JSON200
 	switch {
-	case response == nil:
-		return "", fmt.Errorf("missing respone")
-	case response.Items != nil && len(*response.Items) > 1:
+	case len(items) > 1:
 		return "", fmt.Errorf("cannot pick the correct Organization, define it in the Tenant annotation using the key %s", annotations.OrganizationAnnotation)
-	case response.Items != nil && len(*response.Items) == 1:
-		return string(*(*response.Items)[0].Id), nil
+	case len(items) == 1:
+		return string(*items[0].Id), nil
 	default:
 		return "", fmt.Errorf("unknown condition")
 	}
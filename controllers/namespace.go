@@ -6,6 +6,8 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
 	corev1 "k8s.io/api/core/v1"
@@ -23,20 +25,38 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
 )
 
+// rejectedClusterExpiration is the time after which the manual registration of Namespaces is attempted again
+// on a cluster which rejected it, since its configuration could have been changed.
+const rejectedClusterExpiration = time.Hour
+
 type Namespace struct {
+	// DefaultClusterID is the CloudCasa cluster ID of the Tenants without the cluster ID annotation, if any.
+	DefaultClusterID string
+
 	client       client.Client
 	capsuleLabel string
+	cloudCasa    cloudcasa.Service
+	extractor    annotations.Annotations
+	// rejectedClusters contains the CloudCasa clusters refusing the manual registration of Namespaces,
+	// relying on the CloudCasa agent discovery, along with the time of the rejection.
+	rejectedClusters sync.Map
 }
 
-func (n *Namespace) SetupWithManager(mgr manager.Manager) error {
+func (n *Namespace) SetupWithManager(cc cloudcasa.Service, mgr manager.Manager) error {
 	capsuleLabel, err := capsulev1beta2.GetTypeLabel(&capsulev1beta2.Tenant{})
 	if err != nil {
 		return err
 	}
 
 	n.capsuleLabel = capsuleLabel
+	n.cloudCasa = cc
+	n.extractor = &annotations.Extractor{DefaultClusterID: n.DefaultClusterID}
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Watches(source.NewKindWithCache(&corev1.Namespace{}, mgr.GetCache()), handler.Funcs{
@@ -72,6 +92,8 @@ func (n *Namespace) Reconcile(ctx context.Context, request reconcile.Request) (r
 		return reconcile.Result{}, err
 	}
 
+	reconciled := make([]corev1.Namespace, 0, len(namespaceList.Items))
+
 	for _, ns := range namespaceList.Items {
 		logger.Info(fmt.Sprintf("Reconciling Namespace %s", ns.GetName()))
 
@@ -80,6 +102,18 @@ func (n *Namespace) Reconcile(ctx context.Context, request reconcile.Request) (r
 
 			continue
 		}
+
+		reconciled = append(reconciled, ns)
+	}
+
+	if err := n.registerNamespaces(ctx, reconciled, tnt); err != nil {
+		logger.Error(err, "cannot register Namespaces in CloudCasa")
+	}
+
+	if n.extractor.SyncBackups(tnt) {
+		if err := n.syncBackups(ctx, tnt); err != nil {
+			return reconcile.Result{}, err
+		}
 	}
 
 	logger.Info("Reconciliation completed")
@@ -148,6 +182,68 @@ func (n *Namespace) reconcileNamespace(ctx context.Context, ns *corev1.Namespace
 	return err
 }
 
+// registerNamespaces creates the CloudCasa Kubenamespaces for the given Namespaces, without waiting for the
+// CloudCasa agent to discover them: if the manual registration is rejected, the discovery is awaited
+// until the rejection expires.
+func (n *Namespace) registerNamespaces(ctx context.Context, namespaces []corev1.Namespace, tnt *capsulev1beta2.Tenant) error {
+	clusterID, ok := n.extractor.ClusterID(tnt)
+	if !ok || len(namespaces) == 0 {
+		return nil
+	}
+
+	if rejectedAt, rejected := n.rejectedClusters.Load(clusterID); rejected {
+		if time.Since(rejectedAt.(time.Time)) < rejectedClusterExpiration {
+			return nil
+		}
+
+		n.rejectedClusters.Delete(clusterID)
+	}
+
+	names := make([]string, 0, len(namespaces))
+
+	for _, ns := range namespaces {
+		names = append(names, ns.GetName())
+	}
+
+	resolved, err := n.cloudCasa.ResolveNamespaces(ctx, clusterID, names)
+	if err != nil {
+		return err
+	}
+
+	for _, ns := range namespaces {
+		uid := string(ns.GetUID())
+		// Kubernetes Namespace exists on CloudCasa
+		if kubeNamespace, found := resolved[ns.GetName()]; found {
+			if kubeNamespace.K8sUid != nil && *kubeNamespace.K8sUid != uid {
+				log.FromContext(ctx).Info(fmt.Sprintf("CloudCasa Kubenamespace %s refers to a different Namespace, expected UID %s, got %s", ns.GetName(), uid, *kubeNamespace.K8sUid))
+			}
+
+			continue
+		}
+
+		err = n.cloudCasa.CreateNamespace(ctx, oapi.Kubenamespace{
+			ClusterId: oapi.KubeclusterId(clusterID),
+			K8sUid:    &uid,
+			Name:      ns.GetName(),
+		})
+
+		switch {
+		case cloudcasa.IsRejected(err):
+			n.rejectedClusters.Store(clusterID, time.Now())
+
+			log.FromContext(ctx).Info(fmt.Sprintf("CloudCasa rejected the registration of Namespace %s (%s), waiting for the cluster discovery", ns.GetName(), err.Error()))
+
+			return nil
+		case err != nil:
+			return err
+		}
+
+		log.FromContext(ctx).Info(fmt.Sprintf("registered Namespace %s in CloudCasa", ns.GetName()))
+	}
+
+	return nil
+}
+
 func (n *Namespace) InjectClient(client client.Client) error {
 	n.client = client
 
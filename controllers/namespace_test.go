@@ -0,0 +1,143 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// resolvingService counts the Kubenamespace lookups.
+type resolvingService struct {
+	*fake.Service
+
+	lookups int
+}
+
+func (s *resolvingService) ResolveNamespaces(ctx context.Context, clusterID string, names []string) (map[string]oapi.Kubenamespace, error) {
+	s.lookups++
+
+	return s.Service.ResolveNamespaces(ctx, clusterID, names)
+}
+
+func (s *resolvingService) FindNamespace(ctx context.Context, clusterID, name string) (*oapi.Kubenamespace, error) {
+	s.lookups++
+
+	return s.Service.FindNamespace(ctx, clusterID, name)
+}
+
+func registrationFixtures() (*resolvingService, string, *capsulev1beta2.Tenant, []corev1.Namespace) {
+	service := &resolvingService{Service: fake.New()}
+
+	clusterID := service.AddCluster("solar-cluster")
+	service.AddNamespace(clusterID, "solar-prod", "prod-uid")
+
+	tenant := &capsulev1beta2.Tenant{ObjectMeta: metav1.ObjectMeta{
+		Name:        "solar",
+		Annotations: map[string]string{annotations.ClusterIDAnnotation: clusterID},
+	}}
+
+	namespaces := []corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "solar-prod", UID: "prod-uid"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "solar-dev", UID: "dev-uid"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "solar-test", UID: "test-uid"}},
+	}
+
+	return service, clusterID, tenant, namespaces
+}
+
+// registeredUIDs returns the UIDs of the Kubenamespaces of the given cluster, indexed by name.
+func registeredUIDs(ctx context.Context, t *testing.T, service *resolvingService, clusterID string) map[string]types.UID {
+	t.Helper()
+
+	resolved, err := service.Service.ResolveNamespaces(ctx, clusterID, []string{"solar-prod", "solar-dev", "solar-test"})
+	if err != nil {
+		t.Fatalf("cannot resolve the Kubenamespaces: %s", err.Error())
+	}
+
+	uids := make(map[string]types.UID, len(resolved))
+
+	for name, kubeNamespace := range resolved {
+		uids[name] = types.UID(*kubeNamespace.K8sUid)
+	}
+
+	return uids
+}
+
+func TestRegisterNamespaces(t *testing.T) {
+	ctx := context.Background()
+
+	service, clusterID, tenant, namespaces := registrationFixtures()
+
+	n := &Namespace{cloudCasa: service, extractor: &annotations.Extractor{}}
+
+	if err := n.registerNamespaces(ctx, namespaces, tenant); err != nil {
+		t.Fatalf("cannot register the Namespaces: %s", err.Error())
+	}
+
+	if service.lookups != 1 {
+		t.Fatalf("expected the Kubenamespaces to be resolved at once, got %d lookups", service.lookups)
+	}
+
+	uids := registeredUIDs(ctx, t, service, clusterID)
+
+	for _, ns := range namespaces {
+		if uids[ns.GetName()] != ns.GetUID() {
+			t.Fatalf("expected Namespace %s to be registered with UID %s, got %q", ns.GetName(), ns.GetUID(), uids[ns.GetName()])
+		}
+	}
+}
+
+func TestRegisterNamespacesRejected(t *testing.T) {
+	ctx := context.Background()
+
+	service, clusterID, tenant, namespaces := registrationFixtures()
+	service.RejectNamespaces = true
+
+	n := &Namespace{cloudCasa: service, extractor: &annotations.Extractor{}}
+
+	if err := n.registerNamespaces(ctx, namespaces, tenant); err != nil {
+		t.Fatalf("expected the rejection to be tolerated, got %s", err.Error())
+	}
+
+	if _, rejected := n.rejectedClusters.Load(clusterID); !rejected {
+		t.Fatal("expected the cluster to be tracked as rejecting the registration")
+	}
+	// The cluster configuration changed, but the rejection has not yet expired
+	service.RejectNamespaces = false
+
+	lookups := service.lookups
+
+	if err := n.registerNamespaces(ctx, namespaces, tenant); err != nil {
+		t.Fatalf("cannot register the Namespaces: %s", err.Error())
+	}
+
+	if service.lookups != lookups || len(registeredUIDs(ctx, t, service, clusterID)) != 1 {
+		t.Fatal("expected the registration to be skipped until the rejection expires")
+	}
+
+	n.rejectedClusters.Store(clusterID, time.Now().Add(-rejectedClusterExpiration))
+
+	if err := n.registerNamespaces(ctx, namespaces, tenant); err != nil {
+		t.Fatalf("cannot register the Namespaces: %s", err.Error())
+	}
+
+	if uids := registeredUIDs(ctx, t, service, clusterID); len(uids) != len(namespaces) {
+		t.Fatalf("expected the Namespaces to be registered once the rejection expired, got %v", uids)
+	}
+
+	if _, rejected := n.rejectedClusters.Load(clusterID); rejected {
+		t.Fatal("expected the rejection to be cleared upon the successful registration")
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// ensureOwners invites the Tenant owners to CloudCasa and revokes the access of the former ones,
+// returning the delay after which the pending invitations must be checked again.
+func (m *Manager) ensureOwners(ctx context.Context, tenant *capsulev1beta2.Tenant, status *tenantStatus) (requeueAfter time.Duration) {
+	logger := log.FromContext(ctx)
+
+	var invitationErrs, declined []string
+
+	emails, err := m.ownerEmails(ctx, tenant)
+	if err != nil {
+		logger.Error(err, fmt.Sprintf("failed resolving owners for Tenant %s", tenant.GetName()))
+
+		invitationErrs = append(invitationErrs, err.Error())
+	}
+
+	for _, email := range emails {
+		userRequeueAfter, userErr := m.ensureUser(ctx, email, tenant)
+
+		switch {
+		case goerr.Is(userErr, errInvitationDeclined):
+			declined = append(declined, email)
+		case userErr != nil:
+			logger.Error(userErr, fmt.Sprintf("failed ensuring user %s for Tenant %s", email, tenant.GetName()))
+
+			invitationErrs = append(invitationErrs, fmt.Sprintf("%s: %s", email, userErr.Error()))
+		}
+
+		if userRequeueAfter > 0 && (requeueAfter == 0 || userRequeueAfter < requeueAfter) {
+			requeueAfter = userRequeueAfter
+		}
+	}
+	// Revoking the access only when all the owners have been resolved,
+	// otherwise members of unresolved groups would be removed.
+	if err == nil {
+		if err = m.revokeStaleOwners(ctx, tenant, emails); err != nil {
+			logger.Error(err, fmt.Sprintf("failed revoking stale owners for Tenant %s", tenant.GetName()))
+
+			invitationErrs = append(invitationErrs, fmt.Sprintf("revocation: %s", err.Error()))
+		}
+	}
+
+	switch {
+	case len(invitationErrs) > 0:
+		status.setFalse(InvitesSentCondition, "InvitationFailed", fmt.Errorf("cannot invite owners: %s", strings.Join(invitationErrs, "; ")))
+	case len(declined) > 0:
+		status.setFalse(InvitesSentCondition, "InvitationDeclined", fmt.Errorf("owners declined the CloudCasa invitation: %s", strings.Join(declined, ", ")))
+	default:
+		status.setTrue(InvitesSentCondition, "InvitesSent", "all Tenant owners have been invited to CloudCasa")
+	}
+
+	return requeueAfter
+}
+
+// ownerEmails returns the emails that must have access to the Tenant UserGroup:
+// Group owners are expanded into their members, ServiceAccount ones are skipped since they can't be invited.
+// All the owners are resolved, returning the resolved emails along with the aggregated errors of the other ones.
+func (m *Manager) ownerEmails(ctx context.Context, tenant *capsulev1beta2.Tenant) ([]string, error) {
+	emails, set := make([]string, 0, len(tenant.Spec.Owners)), sets.NewString()
+
+	var errs []error
+
+	add := func(email string) {
+		if set.Has(strings.ToLower(email)) {
+			return
+		}
+
+		set.Insert(strings.ToLower(email))
+		emails = append(emails, email)
+	}
+
+	for _, owner := range tenant.Spec.Owners {
+		switch owner.Kind {
+		case capsulev1beta2.ServiceAccountOwner:
+			log.FromContext(ctx).V(1).Info(fmt.Sprintf("skipping ServiceAccount owner %s, it cannot be invited to CloudCasa", owner.Name))
+		case capsulev1beta2.GroupOwner:
+			if email, ok := m.extractor.OwnerEmailOverride(tenant, owner); ok {
+				add(email)
+
+				continue
+			}
+
+			if m.GroupResolver == nil {
+				log.FromContext(ctx).V(1).Info(fmt.Sprintf("skipping Group owner %s, no group resolver has been configured", owner.Name))
+
+				continue
+			}
+
+			members, err := m.GroupResolver.Members(ctx, owner.Name)
+			if err != nil {
+				errs = append(errs, goerr.Wrap(err, fmt.Sprintf("cannot resolve members of Group owner %s", owner.Name)))
+
+				continue
+			}
+
+			for _, member := range members {
+				add(member)
+			}
+		default:
+			add(m.extractor.OwnerEmail(tenant, owner))
+		}
+	}
+
+	return emails, utilerrors.NewAggregate(errs)
+}
+
+// revokeStaleOwners removes from the Tenant UserGroup the users no more listed as Tenant owners,
+// deleting also their pending invitations: only the users invited on behalf of the Tenant are removed,
+// the ones added by administrators are retained.
+func (m *Manager) revokeStaleOwners(ctx context.Context, tenant *capsulev1beta2.Tenant, owners []string) error {
+	emails := make(map[string]struct{}, len(owners))
+
+	for _, owner := range owners {
+		emails[strings.ToLower(owner)] = struct{}{}
+	}
+
+	if err := m.revokeStaleInvitations(ctx, tenant, emails); err != nil {
+		return err
+	}
+
+	invited, err := m.invitedEmails(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	etag, userGroup, err := m.retrieveUserGroup(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	return m.removeUserGroupUsers(ctx, etag, userGroup, func(email string) bool {
+		if _, ok := emails[strings.ToLower(email)]; ok {
+			return false
+		}
+
+		if _, ok := invited[strings.ToLower(email)]; !ok {
+			return false
+		}
+
+		log.FromContext(ctx).Info(fmt.Sprintf("removing user %s from CloudCasa UserGroup, no more a Tenant owner", email))
+
+		return true
+	})
+}
+
+// removeUserGroupUsers removes from the UserGroup the users whose email matches the given function.
+func (m *Manager) removeUserGroupUsers(ctx context.Context, etag string, userGroup *oapi.Usergroup, remove func(email string) bool) error {
+	if userGroup.Users == nil || len(*userGroup.Users) == 0 {
+		return nil
+	}
+
+	users, err := m.retrieveUsers(ctx, *userGroup.Users)
+	if err != nil {
+		return err
+	}
+
+	retained := make([]string, 0, len(*userGroup.Users))
+
+	for _, id := range *userGroup.Users {
+		email, ok := users[id]
+		if !ok {
+			// The user cannot be resolved, keeping it since it could be not managed by the addon
+			retained = append(retained, id)
+
+			continue
+		}
+
+		if remove(email) {
+			continue
+		}
+
+		retained = append(retained, id)
+	}
+
+	if len(retained) == len(*userGroup.Users) {
+		return nil
+	}
+
+	return m.updateUserGroupUsers(ctx, etag, userGroup, retained)
+}
+
+// invitedEmails returns the lower-cased emails of the users invited to CloudCasa on behalf of the Tenant.
+func (m *Manager) invitedEmails(ctx context.Context, tenant *capsulev1beta2.Tenant) (map[string]struct{}, error) {
+	invites, err := m.listTenantInvitations(ctx, tenant, "")
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make(map[string]struct{}, len(invites))
+
+	for _, invite := range invites {
+		emails[strings.ToLower(invite.Email)] = struct{}{}
+	}
+
+	return emails, nil
+}
+
+func (m *Manager) revokeStaleInvitations(ctx context.Context, tenant *capsulev1beta2.Tenant, emails map[string]struct{}) error {
+	invites, err := m.listTenantInvitations(ctx, tenant, oapi.OrginviteStatePENDING)
+	if err != nil {
+		return err
+	}
+
+	for _, invite := range invites {
+		if _, ok := emails[strings.ToLower(invite.Email)]; ok {
+			continue
+		}
+
+		log.FromContext(ctx).Info(fmt.Sprintf("deleting pending invitation for %s, no more a Tenant owner", invite.Email))
+
+		if err = m.cloudCasa.DeleteInvite(ctx, *invite.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retrieveUsers returns the emails of the given CloudCasa users, indexed by their ID.
+func (m *Manager) retrieveUsers(ctx context.Context, ids []string) (map[string]string, error) {
+	items, err := m.cloudCasa.ListUsers(ctx, cloudcasa.UserFilter{IDs: ids})
+	if err != nil {
+		return nil, goerr.Wrap(err, "cannot retrieve list of CloudCasa users")
+	}
+
+	users := make(map[string]string, len(ids))
+
+	for _, user := range items {
+		users[string(*user.Id)] = user.Email
+	}
+
+	return users, nil
+}
+
+func (m *Manager) updateUserGroupUsers(ctx context.Context, etag string, userGroup *oapi.Usergroup, users []string) error {
+	if err := m.cloudCasa.PatchUserGroup(ctx, *userGroup.Id, etag, oapi.Usergroup{Name: userGroup.Name, Users: &users}); err != nil {
+		return goerr.Wrap(err, "cannot update CloudCasa UserGroup users")
+	}
+
+	return nil
+}
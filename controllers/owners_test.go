@@ -0,0 +1,165 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/groups"
+)
+
+// staticResolver resolves the Group owners from the given mapping.
+type staticResolver map[string][]string
+
+func (r staticResolver) Members(_ context.Context, group string) ([]string, error) {
+	members, ok := r[group]
+	if !ok {
+		return nil, fmt.Errorf("group %s is not defined", group)
+	}
+
+	return members, nil
+}
+
+func TestOwnerEmails(t *testing.T) {
+	tests := map[string]struct {
+		owners      capsulev1beta2.OwnerListSpec
+		annotations map[string]string
+		resolver    groups.Resolver
+		expected    []string
+		failed      bool
+	}{
+		"users": {
+			owners: capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.UserOwner, Name: "alice@clastix.io"},
+				{Kind: capsulev1beta2.UserOwner, Name: "bob@clastix.io"},
+			},
+			expected: []string{"alice@clastix.io", "bob@clastix.io"},
+		},
+		"email override": {
+			owners: capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.UserOwner, Name: "alice"},
+			},
+			annotations: map[string]string{annotations.UserEmailOverridePattern + "/user.alice": "alice@clastix.io"},
+			expected:    []string{"alice@clastix.io"},
+		},
+		"ServiceAccount skipped": {
+			owners: capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.ServiceAccountOwner, Name: "system:serviceaccount:solar:robot"},
+				{Kind: capsulev1beta2.UserOwner, Name: "alice@clastix.io"},
+			},
+			expected: []string{"alice@clastix.io"},
+		},
+		"duplicates ignoring case": {
+			owners: capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.UserOwner, Name: "alice@clastix.io"},
+				{Kind: capsulev1beta2.UserOwner, Name: "Alice@Clastix.io"},
+			},
+			expected: []string{"alice@clastix.io"},
+		},
+		"Group without resolver skipped": {
+			owners: capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.GroupOwner, Name: "developers"},
+				{Kind: capsulev1beta2.UserOwner, Name: "alice@clastix.io"},
+			},
+			expected: []string{"alice@clastix.io"},
+		},
+		"Group members": {
+			owners: capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.UserOwner, Name: "alice@clastix.io"},
+				{Kind: capsulev1beta2.GroupOwner, Name: "developers"},
+			},
+			resolver: staticResolver{"developers": {"bob@clastix.io", "alice@clastix.io"}},
+			expected: []string{"alice@clastix.io", "bob@clastix.io"},
+		},
+		"Group email override": {
+			owners: capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.GroupOwner, Name: "developers"},
+			},
+			annotations: map[string]string{annotations.UserEmailOverridePattern + "/group.developers": "developers@clastix.io"},
+			resolver:    staticResolver{"developers": {"bob@clastix.io"}},
+			expected:    []string{"developers@clastix.io"},
+		},
+		"unresolved Group": {
+			owners: capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.GroupOwner, Name: "developers"},
+				{Kind: capsulev1beta2.UserOwner, Name: "alice@clastix.io"},
+			},
+			resolver: staticResolver{},
+			expected: []string{"alice@clastix.io"},
+			failed:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			tenant := &capsulev1beta2.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "solar", Annotations: tc.annotations},
+				Spec:       capsulev1beta2.TenantSpec{Owners: tc.owners},
+			}
+
+			m := &Manager{GroupResolver: tc.resolver, extractor: &annotations.Extractor{}}
+
+			emails, err := m.ownerEmails(context.Background(), tenant)
+
+			switch {
+			case tc.failed && err == nil:
+				t.Fatal("expected the unresolved owners to be reported")
+			case !tc.failed && err != nil:
+				t.Fatalf("cannot resolve the owners: %s", err.Error())
+			}
+
+			if !reflect.DeepEqual(emails, tc.expected) {
+				t.Fatalf("expected the emails %v, got %v", tc.expected, emails)
+			}
+		})
+	}
+}
+
+func TestRevokeStaleOwners(t *testing.T) {
+	ctx := context.Background()
+
+	service := fake.New()
+
+	id := seedUserGroup(ctx, t, service, "solar", []string{"alice@clastix.io", "carol@clastix.io"}, []string{"bob@clastix.io"})
+
+	for _, email := range []string{"alice@clastix.io", "dave@clastix.io"} {
+		if err := service.CreateInvite(ctx, oapi.Orginvite{Email: email, Tags: &map[string]interface{}{tenantTag: "solar"}}); err != nil {
+			t.Fatalf("cannot invite %s: %s", email, err.Error())
+		}
+	}
+
+	tenant := &capsulev1beta2.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "solar"}}
+
+	m := &Manager{cloudCasa: service, extractor: &annotations.Extractor{}}
+	// Carol is no more an owner, Bob has been added manually by the administrators
+	if err := m.revokeStaleOwners(ctx, tenant, []string{"Alice@clastix.io"}); err != nil {
+		t.Fatalf("cannot revoke the stale owners: %s", err.Error())
+	}
+
+	if members, expected := memberEmails(ctx, t, service, id), []string{"alice@clastix.io", "bob@clastix.io"}; !sets.NewString(members...).Equal(sets.NewString(expected...)) {
+		t.Fatalf("expected the members %v, got %v", expected, members)
+	}
+
+	invites, err := service.ListInvites(ctx, cloudcasa.InviteFilter{State: oapi.OrginviteStatePENDING})
+	if err != nil {
+		t.Fatalf("cannot list the invitations: %s", err.Error())
+	}
+
+	if len(invites) != 1 || invites[0].Email != "alice@clastix.io" {
+		t.Fatalf("expected only the pending invitation of the owner to be retained, got %v", invites)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// defaultProfile is used when no CloudCasaTenantProfile is selected by the Tenant.
+var defaultProfile = cloudcasav1alpha1.CloudCasaTenantProfileSpec{
+	GlobalPermissions: []string{
+		"policies.create",
+		"kubebackups.create",
+		"kuberestores.create",
+	},
+	ClusterPermissions: []string{
+		"kubeclusters.backup",
+		"kubeclusters.restore",
+	},
+	NamespacePermissions: []string{
+		"kubenamespaces.read",
+	},
+}
+
+// tenantProfile returns the CloudCasaTenantProfile referred by the Tenant annotation,
+// or the first one, sorted by name, selecting the Tenant labels.
+func (m *Manager) tenantProfile(ctx context.Context, tenant *capsulev1beta2.Tenant) (*cloudcasav1alpha1.CloudCasaTenantProfileSpec, error) {
+	if name, ok := m.extractor.Profile(tenant); ok {
+		profile := &cloudcasav1alpha1.CloudCasaTenantProfile{}
+
+		if err := m.client.Get(ctx, types.NamespacedName{Name: name}, profile); err != nil {
+			return nil, goerr.Wrap(err, fmt.Sprintf("cannot retrieve CloudCasaTenantProfile %s", name))
+		}
+
+		return &profile.Spec, nil
+	}
+
+	profiles := &cloudcasav1alpha1.CloudCasaTenantProfileList{}
+
+	if err := m.client.List(ctx, profiles); err != nil {
+		return nil, goerr.Wrap(err, "cannot list CloudCasaTenantProfile")
+	}
+
+	sort.Slice(profiles.Items, func(i, j int) bool {
+		return profiles.Items[i].GetName() < profiles.Items[j].GetName()
+	})
+
+	for i := range profiles.Items {
+		profile := profiles.Items[i]
+
+		if profile.Spec.TenantSelector == nil {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(profile.Spec.TenantSelector)
+		if err != nil {
+			return nil, goerr.Wrap(err, fmt.Sprintf("invalid Tenant selector for CloudCasaTenantProfile %s", profile.GetName()))
+		}
+
+		if selector.Matches(labels.Set(tenant.GetLabels())) {
+			return &profile.Spec, nil
+		}
+	}
+
+	return defaultProfile.DeepCopy(), nil
+}
+
+// renderACLs translates the given profile into the CloudCasa UserGroup ACLs.
+func renderACLs(profile *cloudcasav1alpha1.CloudCasaTenantProfileSpec, clusterID string, namespaceIDs []string) []oapi.ACL {
+	var acls []oapi.ACL
+
+	if len(profile.GlobalPermissions) > 0 {
+		acls = append(acls, oapi.ACL{
+			Permissions: &profile.GlobalPermissions,
+			Resource:    "allresources",
+		})
+	}
+
+	if len(profile.ClusterPermissions) > 0 {
+		acls = append(acls, oapi.ACL{
+			Permissions: &profile.ClusterPermissions,
+			Resource:    "kubeclusters",
+			ResourceIds: &[]string{clusterID},
+		})
+	}
+
+	if len(profile.NamespacePermissions) > 0 {
+		acls = append(acls, oapi.ACL{
+			Permissions: &profile.NamespacePermissions,
+			Resource:    "kubenamespaces",
+			ResourceIds: &namespaceIDs,
+		})
+	}
+
+	return acls
+}
+
+// enqueueProfileTenants reconciles all the Tenants upon a CloudCasaTenantProfile change,
+// since it could be selected, or no more selected, by any of them.
+func (m *Manager) enqueueProfileTenants(client.Object) (requests []reconcile.Request) {
+	tenants := &capsulev1beta2.TenantList{}
+
+	if err := m.client.List(context.Background(), tenants); err != nil {
+		return nil
+	}
+
+	for _, tenant := range tenants.Items {
+		if _, ok := m.extractor.ClusterID(&tenant); !ok {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: tenant.GetName()}})
+	}
+
+	return requests
+}
@@ -3,5 +3,16 @@
 
 package controllers
 
-// +kubebuilder:rbac:groups=capsule.clastix.io,resources=tenants,verbs=get;list;watch
+// +kubebuilder:rbac:groups=capsule.clastix.io,resources=tenants,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=capsule.clastix.io,resources=tenants/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get
+// +kubebuilder:rbac:groups=cloudcasa.capsule.clastix.io,resources=cloudcasatenantprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cloudcasa.capsule.clastix.io,resources=tenantbackups,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cloudcasa.capsule.clastix.io,resources=tenantbackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cloudcasa.capsule.clastix.io,resources=tenantrestores,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cloudcasa.capsule.clastix.io,resources=tenantrestores/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cloudcasa.capsule.clastix.io,resources=tenantbackuppolicies,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=cloudcasa.capsule.clastix.io,resources=tenantbackuppolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cloudcasa.capsule.clastix.io,resources=tenantbackuppolicies/finalizers,verbs=update
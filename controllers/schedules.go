@@ -0,0 +1,197 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	goerr "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+const defaultPolicyTimezone = "UTC"
+
+// scheduledBackup is the desired state of a SCHEDULED CloudCasa Kubebackup, along with the Policy running it:
+// both share the same name and tags.
+type scheduledBackup struct {
+	name                      string
+	clusterID                 string
+	tags                      map[string]interface{}
+	namespaces                []string
+	timezone                  string
+	schedules                 []cloudcasav1alpha1.BackupSchedule
+	snapshotPersistentVolumes *bool
+}
+
+// policySchedule mirrors the anonymous schedule type of the generated Policy model.
+type policySchedule struct {
+	Locked          *bool `json:"locked,omitempty"`
+	NumAlwaysRetain *int  `json:"numAlwaysRetain,omitempty"`
+	RetainDays      int   `json:"retainDays"`
+	Schedule        struct {
+		CronSpec   *string `json:"cronSpec,omitempty"`
+		DayOfMonth *string `json:"dayOfMonth,omitempty"`
+		DayOfWeek  *string `json:"dayOfWeek,omitempty"`
+		Hour       *string `json:"hour,omitempty"`
+		Minute     *string `json:"minute,omitempty"`
+		Month      *string `json:"month,omitempty"`
+	} `json:"schedule"`
+}
+
+// cronFields splits the cron expression in the minute, hour, day of month, month, and day of week fields.
+func cronFields(cron string) ([]string, error) {
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have five fields", cron)
+	}
+
+	return fields, nil
+}
+
+func toPolicySchedule(schedule cloudcasav1alpha1.BackupSchedule) (policySchedule, error) {
+	fields, err := cronFields(schedule.Cron)
+	if err != nil {
+		return policySchedule{}, err
+	}
+
+	cronSpec := strings.Join(fields, " ")
+
+	s := policySchedule{RetainDays: schedule.RetentionDays}
+	s.Schedule.CronSpec = &cronSpec
+	s.Schedule.Minute, s.Schedule.Hour, s.Schedule.DayOfMonth, s.Schedule.Month, s.Schedule.DayOfWeek = &fields[0], &fields[1], &fields[2], &fields[3], &fields[4]
+
+	return s, nil
+}
+
+// ensureScheduledBackup creates, or updates, the Policy and the SCHEDULED Kubebackup, returning their IDs:
+// the Kubebackup is paused when no Namespaces are selected, since CloudCasa would back up the whole cluster.
+func ensureScheduledBackup(ctx context.Context, cc cloudcasa.Service, desired scheduledBackup) (policyID, backupID string, err error) {
+	timezone := desired.timezone
+	if len(timezone) == 0 {
+		timezone = defaultPolicyTimezone
+	}
+
+	tags := desired.tags
+
+	policy := oapi.Policy{Name: desired.name, Timezone: &timezone, Tags: &tags}
+
+	for _, schedule := range desired.schedules {
+		s, scheduleErr := toPolicySchedule(schedule)
+		if scheduleErr != nil {
+			return "", "", scheduleErr
+		}
+
+		policy.Schedules = append(policy.Schedules, s)
+	}
+
+	current, err := cc.EnsurePolicy(ctx, policy)
+	if err != nil {
+		return "", "", goerr.Wrap(err, "cannot create CloudCasa Policy")
+	}
+
+	policyID = string(*current.Id)
+
+	if !samePolicy(current, &policy) {
+		if err = cc.ReplacePolicy(ctx, policyID, policy); err != nil {
+			return policyID, "", goerr.Wrap(err, "cannot update CloudCasa Policy")
+		}
+	}
+
+	namespaces, allNamespaces, pause := sets.NewString(desired.namespaces...).List(), false, len(desired.namespaces) == 0
+	triggerType, policyRef := oapi.KubebackupTriggerTypeSCHEDULED, oapi.PolicyId(policyID)
+
+	backup := oapi.Kubebackup{
+		Cluster:     oapi.KubeclusterId(desired.clusterID),
+		Name:        desired.name,
+		Pause:       &pause,
+		Policy:      &policyRef,
+		Tags:        &tags,
+		TriggerType: &triggerType,
+	}
+	backup.Source.AllNamespaces = &allNamespaces
+	backup.Source.Namespaces = &namespaces
+	backup.Source.SnapshotPersistentVolumes = desired.snapshotPersistentVolumes
+
+	created, err := cc.EnsureBackup(ctx, backup)
+	if err != nil {
+		return policyID, "", goerr.Wrap(err, "cannot create CloudCasa Kubebackup")
+	}
+
+	backupID = string(*created.Id)
+
+	if !sameScheduledBackup(created, &backup) {
+		if err = cc.UpdateBackup(ctx, backupID, backup); err != nil {
+			return policyID, backupID, goerr.Wrap(err, "cannot update CloudCasa Kubebackup")
+		}
+	}
+
+	return policyID, backupID, nil
+}
+
+// deleteScheduledBackup deletes the Kubebackup and then the Policy running it, skipping the empty IDs.
+func deleteScheduledBackup(ctx context.Context, cc cloudcasa.Service, policyID, backupID string) error {
+	if len(backupID) > 0 {
+		if err := cc.DeleteBackup(ctx, backupID); err != nil {
+			return goerr.Wrap(err, "cannot delete CloudCasa Kubebackup")
+		}
+	}
+
+	if len(policyID) > 0 {
+		if err := cc.DeletePolicy(ctx, policyID); err != nil {
+			return goerr.Wrap(err, "cannot delete CloudCasa Policy")
+		}
+	}
+
+	return nil
+}
+
+func samePolicy(current, desired *oapi.Policy) bool {
+	timezone := defaultPolicyTimezone
+	if current.Timezone != nil {
+		timezone = *current.Timezone
+	}
+
+	if timezone != *desired.Timezone || len(current.Schedules) != len(desired.Schedules) {
+		return false
+	}
+
+	for i := range desired.Schedules {
+		a, b := current.Schedules[i], desired.Schedules[i]
+
+		if a.RetainDays != b.RetainDays || a.Schedule.CronSpec == nil || *a.Schedule.CronSpec != *b.Schedule.CronSpec {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameScheduledBackup(current, desired *oapi.Kubebackup) bool {
+	boolValue := func(b *bool, fallback bool) bool {
+		if b == nil {
+			return fallback
+		}
+
+		return *b
+	}
+
+	switch {
+	case current.Policy == nil || *current.Policy != *desired.Policy:
+		return false
+	case boolValue(current.Pause, false) != *desired.Pause:
+		return false
+	case boolValue(current.Source.SnapshotPersistentVolumes, true) != boolValue(desired.Source.SnapshotPersistentVolumes, true):
+		return false
+	case current.Source.Namespaces == nil:
+		return len(*desired.Source.Namespaces) == 0
+	default:
+		return sets.NewString(*current.Source.Namespaces...).Equal(sets.NewString(*desired.Source.Namespaces...))
+	}
+}
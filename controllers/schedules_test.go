@@ -0,0 +1,237 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+func TestCronFields(t *testing.T) {
+	testCases := []struct {
+		cron     string
+		expected []string
+	}{
+		{cron: "0 2 * * *", expected: []string{"0", "2", "*", "*", "*"}},
+		{cron: "  */15  0-6 1,15 * MON ", expected: []string{"*/15", "0-6", "1,15", "*", "MON"}},
+		{cron: "0\t2 * * *", expected: []string{"0", "2", "*", "*", "*"}},
+		{cron: ""},
+		{cron: "0 2 * *"},
+		{cron: "0 0 2 * * *"},
+		{cron: "@daily"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.cron, func(t *testing.T) {
+			fields, err := cronFields(tc.cron)
+
+			switch {
+			case tc.expected == nil && err == nil:
+				t.Errorf("expected an error, got fields %v", fields)
+			case tc.expected != nil && err != nil:
+				t.Errorf("unexpected error: %s", err.Error())
+			case !reflect.DeepEqual(fields, tc.expected):
+				t.Errorf("expected fields %v, got %v", tc.expected, fields)
+			}
+		})
+	}
+}
+
+// testPolicy returns the Policy with the given timezone and schedules, as rendered by ensureScheduledBackup.
+func testPolicy(t *testing.T, timezone *string, schedules ...cloudcasav1alpha1.BackupSchedule) *oapi.Policy {
+	t.Helper()
+
+	policy := &oapi.Policy{Name: "oil-policy", Timezone: timezone}
+
+	for _, schedule := range schedules {
+		s, err := toPolicySchedule(schedule)
+		if err != nil {
+			t.Fatalf("cannot render schedule: %s", err.Error())
+		}
+
+		policy.Schedules = append(policy.Schedules, s)
+	}
+
+	return policy
+}
+
+func TestSamePolicy(t *testing.T) {
+	utc, rome := "UTC", "Europe/Rome"
+	daily := cloudcasav1alpha1.BackupSchedule{Cron: "0 2 * * *", RetentionDays: 7}
+	weekly := cloudcasav1alpha1.BackupSchedule{Cron: "0 3 * * 0", RetentionDays: 30}
+
+	testCases := []struct {
+		name     string
+		current  *oapi.Policy
+		desired  *oapi.Policy
+		expected bool
+	}{
+		{name: "same schedules", current: testPolicy(t, &utc, daily, weekly), desired: testPolicy(t, &utc, daily, weekly), expected: true},
+		{name: "missing timezone defaults to UTC", current: testPolicy(t, nil, daily), desired: testPolicy(t, &utc, daily), expected: true},
+		{name: "different timezone", current: testPolicy(t, &utc, daily), desired: testPolicy(t, &rome, daily)},
+		{name: "added schedule", current: testPolicy(t, &utc, daily), desired: testPolicy(t, &utc, daily, weekly)},
+		{name: "reordered schedules", current: testPolicy(t, &utc, weekly, daily), desired: testPolicy(t, &utc, daily, weekly)},
+		{
+			name:    "different retention",
+			current: testPolicy(t, &utc, daily),
+			desired: testPolicy(t, &utc, cloudcasav1alpha1.BackupSchedule{Cron: daily.Cron, RetentionDays: 14}),
+		},
+		{
+			name:    "different cron expression",
+			current: testPolicy(t, &utc, daily),
+			desired: testPolicy(t, &utc, cloudcasav1alpha1.BackupSchedule{Cron: "0 4 * * *", RetentionDays: daily.RetentionDays}),
+		},
+		{
+			name: "missing cron expression",
+			current: func() *oapi.Policy {
+				policy := testPolicy(t, &utc, daily)
+				policy.Schedules[0].Schedule.CronSpec = nil
+
+				return policy
+			}(),
+			desired: testPolicy(t, &utc, daily),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if same := samePolicy(tc.current, tc.desired); same != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, same)
+			}
+		})
+	}
+}
+
+func TestSameScheduledBackup(t *testing.T) {
+	backup := func(policyID *string, pause, snapshot *bool, namespaces *[]string) *oapi.Kubebackup {
+		item := &oapi.Kubebackup{Name: "oil-backup", Pause: pause}
+		if policyID != nil {
+			policy := oapi.PolicyId(*policyID)
+			item.Policy = &policy
+		}
+
+		item.Source.SnapshotPersistentVolumes = snapshot
+		item.Source.Namespaces = namespaces
+
+		return item
+	}
+
+	policyID, otherPolicyID := "62d1a0b3c5e2f1a4b7c8d9e0", "62d1a0b3c5e2f1a4b7c8d9e1"
+	yes, no := true, false
+	namespaces, reordered, empty := []string{"oil-production", "oil-development"}, []string{"oil-development", "oil-production"}, []string{}
+
+	testCases := []struct {
+		name     string
+		current  *oapi.Kubebackup
+		desired  *oapi.Kubebackup
+		expected bool
+	}{
+		{name: "same backup", current: backup(&policyID, &no, &yes, &namespaces), desired: backup(&policyID, &no, &yes, &namespaces), expected: true},
+		{name: "reordered Namespaces", current: backup(&policyID, &no, nil, &reordered), desired: backup(&policyID, &no, nil, &namespaces), expected: true},
+		{name: "missing pause defaults to false", current: backup(&policyID, nil, nil, &namespaces), desired: backup(&policyID, &no, nil, &namespaces), expected: true},
+		{name: "missing snapshot defaults to true", current: backup(&policyID, &no, nil, &namespaces), desired: backup(&policyID, &no, &yes, &namespaces), expected: true},
+		{name: "missing Namespaces when none are desired", current: backup(&policyID, &yes, nil, nil), desired: backup(&policyID, &yes, nil, &empty), expected: true},
+		{name: "missing policy", current: backup(nil, &no, nil, &namespaces), desired: backup(&policyID, &no, nil, &namespaces)},
+		{name: "different policy", current: backup(&otherPolicyID, &no, nil, &namespaces), desired: backup(&policyID, &no, nil, &namespaces)},
+		{name: "paused", current: backup(&policyID, &no, nil, &empty), desired: backup(&policyID, &yes, nil, &empty)},
+		{name: "snapshot disabled", current: backup(&policyID, &no, nil, &namespaces), desired: backup(&policyID, &no, &no, &namespaces)},
+		{name: "missing Namespaces", current: backup(&policyID, &no, nil, nil), desired: backup(&policyID, &no, nil, &namespaces)},
+		{name: "different Namespaces", current: backup(&policyID, &no, nil, &namespaces), desired: backup(&policyID, &no, nil, &[]string{"oil-production"})},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if same := sameScheduledBackup(tc.current, tc.desired); same != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, same)
+			}
+		})
+	}
+}
+
+func TestEnsureScheduledBackup(t *testing.T) {
+	ctx := context.Background()
+
+	service := fake.New()
+	clusterID := service.AddCluster("capsule")
+
+	utc := defaultPolicyTimezone
+
+	desired := scheduledBackup{
+		name:      "oil-policy-62d1a0b3",
+		clusterID: clusterID,
+		tags:      map[string]interface{}{tenantTag: "oil"},
+		schedules: []cloudcasav1alpha1.BackupSchedule{{Cron: "0 2 * * *", RetentionDays: 7}},
+	}
+
+	steps := []struct {
+		name       string
+		namespaces []string
+		schedules  []cloudcasav1alpha1.BackupSchedule
+		pause      bool
+	}{
+		{name: "paused without Namespaces", pause: true},
+		{name: "resumed with Namespaces", namespaces: []string{"oil-production", "oil-development"}},
+		{name: "Namespaces changed", namespaces: []string{"oil-production"}},
+		{name: "schedules changed", namespaces: []string{"oil-production"}, schedules: []cloudcasav1alpha1.BackupSchedule{{Cron: "0 3 * * 0", RetentionDays: 30}}},
+	}
+
+	var policyID, backupID string
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			desired.namespaces = step.namespaces
+			if step.schedules != nil {
+				desired.schedules = step.schedules
+			}
+
+			currentPolicyID, currentBackupID, err := ensureScheduledBackup(ctx, service, desired)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			// The Policy and Kubebackup are created once, and then updated
+			if len(policyID) > 0 && (currentPolicyID != policyID || currentBackupID != backupID) {
+				t.Fatalf("expected Policy %s and Kubebackup %s, got %s and %s", policyID, backupID, currentPolicyID, currentBackupID)
+			}
+
+			policyID, backupID = currentPolicyID, currentBackupID
+
+			backup, err := service.GetBackup(ctx, backupID)
+			if err != nil {
+				t.Fatalf("cannot retrieve Kubebackup: %s", err.Error())
+			}
+
+			if backup.Pause == nil || *backup.Pause != step.pause {
+				t.Errorf("expected Kubebackup pause to be %t", step.pause)
+			}
+
+			if backup.Source.Namespaces == nil || !sets.NewString(*backup.Source.Namespaces...).Equal(sets.NewString(step.namespaces...)) {
+				t.Errorf("expected Kubebackup Namespaces %v, got %v", step.namespaces, backup.Source.Namespaces)
+			}
+
+			policy, err := service.EnsurePolicy(ctx, oapi.Policy{Name: desired.name})
+			if err != nil {
+				t.Fatalf("cannot retrieve Policy: %s", err.Error())
+			}
+
+			if !samePolicy(policy, testPolicy(t, &utc, desired.schedules...)) {
+				t.Errorf("expected Policy schedules %v", desired.schedules)
+			}
+		})
+	}
+
+	if err := deleteScheduledBackup(ctx, service, policyID, backupID); err != nil {
+		t.Fatalf("cannot delete the scheduled backup: %s", err.Error())
+	}
+
+	if _, err := service.GetBackup(ctx, backupID); err == nil {
+		t.Error("expected the Kubebackup to be deleted")
+	}
+}
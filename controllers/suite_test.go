@@ -0,0 +1,229 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/auth"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/simulator"
+)
+
+const (
+	testToken     = "envtest"
+	testClusterID = "62d1a0b3c5e2f1a4b7c8d9e0"
+	testTimeout   = 30 * time.Second
+)
+
+var (
+	// skipEnvTest is the reason the envtest based tests are skipped, if any.
+	skipEnvTest string
+	k8sClient   client.Client
+	cloudCasa   *simulator.Simulator
+)
+
+// capsuleCRDs returns the path of the Capsule CRDs, shipped with the Go module.
+func capsuleCRDs() (string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/clastix/capsule").Output()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(strings.TrimSpace(string(out)), "config", "crd", "bases"), nil
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(capsulev1beta2.AddToScheme(scheme))
+	utilruntime.Must(cloudcasav1alpha1.AddToScheme(scheme))
+
+	return scheme
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	if len(os.Getenv("KUBEBUILDER_ASSETS")) == 0 {
+		skipEnvTest = "KUBEBUILDER_ASSETS is not set, the envtest binaries are set up by make test"
+
+		return m.Run()
+	}
+
+	crds, err := capsuleCRDs()
+	if err != nil {
+		fmt.Printf("cannot find the Capsule CRDs: %s\n", err.Error())
+
+		return 1
+	}
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     []string{crds, filepath.Join("..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+	}
+
+	cfg, err := testEnv.Start()
+	if err != nil {
+		fmt.Printf("cannot start the envtest environment: %s\n", err.Error())
+
+		return 1
+	}
+
+	defer func() {
+		_ = testEnv.Stop()
+	}()
+
+	scheme := newScheme()
+
+	cloudCasa = simulator.New()
+	cloudCasa.Token = testToken
+	cloudCasa.Insert(simulator.Orgs, simulator.Document{"name": "envtest"})
+
+	server := simulator.NewServer(cloudCasa)
+	defer server.Close()
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{Scheme: scheme, MetricsBindAddress: "0"})
+	if err != nil {
+		fmt.Printf("cannot create the manager: %s\n", err.Error())
+
+		return 1
+	}
+
+	cc, err := cloudcasa.NewClient(server.URL, auth.NewToken(testToken), http.DefaultClient)
+	if err != nil {
+		fmt.Printf("cannot create the CloudCasa client: %s\n", err.Error())
+
+		return 1
+	}
+
+	if err = (&Manager{InventoryTimeout: time.Minute}).SetupWithManager(cc, mgr); err != nil {
+		fmt.Printf("cannot set up the Tenant controller: %s\n", err.Error())
+
+		return 1
+	}
+
+	if err = (&Namespace{}).SetupWithManager(cc, mgr); err != nil {
+		fmt.Printf("cannot set up the Namespace controller: %s\n", err.Error())
+
+		return 1
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		if err := mgr.Start(ctx); err != nil {
+			fmt.Printf("cannot start the manager: %s\n", err.Error())
+		}
+	}()
+
+	k8sClient = mgr.GetClient()
+
+	return m.Run()
+}
+
+func requireEnvTest(t *testing.T) {
+	t.Helper()
+
+	if len(skipEnvTest) > 0 {
+		t.Skip(skipEnvTest)
+	}
+}
+
+// eventually polls the given assertion until it succeeds, failing the test upon timeout.
+func eventually(t *testing.T, assertion func() error) {
+	t.Helper()
+
+	var err error
+
+	for deadline := time.Now().Add(testTimeout); time.Now().Before(deadline); time.Sleep(250 * time.Millisecond) {
+		if err = assertion(); err == nil {
+			return
+		}
+	}
+
+	t.Fatalf("assertion not satisfied after %s: %s", testTimeout, err.Error())
+}
+
+// newFakeClient returns a client backed by an in-memory tracker, for the tests not requiring envtest.
+func newFakeClient(objects ...client.Object) client.Client {
+	return fakeclient.NewClientBuilder().WithScheme(newScheme()).WithObjects(objects...).Build()
+}
+
+// tenantObjects returns the Tenant owning the given Namespaces, along with them.
+func tenantObjects(t *testing.T, name string, tenantAnnotations map[string]string, namespaces ...string) []client.Object {
+	t.Helper()
+
+	capsuleLabel, err := capsulev1beta2.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		t.Fatalf("cannot retrieve the Capsule Tenant label: %s", err.Error())
+	}
+
+	tenant := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name + "-uid"), Annotations: tenantAnnotations},
+		Status:     capsulev1beta2.TenantStatus{Namespaces: namespaces, Size: uint(len(namespaces))},
+	}
+
+	objects := []client.Object{tenant}
+
+	for _, namespace := range namespaces {
+		objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: map[string]string{capsuleLabel: name}}})
+	}
+
+	return objects
+}
+
+// faultyService fails the calls of the fake CloudCasa Service with the given errors, if set.
+type faultyService struct {
+	*fake.Service
+
+	runBackupErr    error
+	ensurePolicyErr error
+	// runBackupCalls counts the Kubebackup runs requested.
+	runBackupCalls int
+}
+
+func (s *faultyService) RunBackup(ctx context.Context, id, name string, retentionDays int) error {
+	s.runBackupCalls++
+
+	if s.runBackupErr != nil {
+		return s.runBackupErr
+	}
+
+	return s.Service.RunBackup(ctx, id, name, retentionDays)
+}
+
+func (s *faultyService) EnsurePolicy(ctx context.Context, policy oapi.Policy) (*oapi.Policy, error) {
+	if s.ensurePolicyErr != nil {
+		return nil, s.ensurePolicyErr
+	}
+
+	return s.Service.EnsurePolicy(ctx, policy)
+}
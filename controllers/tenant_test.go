@@ -0,0 +1,228 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/simulator"
+)
+
+func userGroupOf(tenant string) (simulator.Document, error) {
+	items := cloudCasa.List(simulator.UserGroups, simulator.Filter{"name": tenant})
+	if len(items) != 1 {
+		return nil, fmt.Errorf("expected one UserGroup for Tenant %s, found %d", tenant, len(items))
+	}
+
+	return items[0], nil
+}
+
+func pendingInvites(tenant string) map[string]struct{} {
+	emails := map[string]struct{}{}
+
+	for _, invite := range cloudCasa.List(simulator.OrgInvites, simulator.Filter{"tags." + tenantTag: tenant, "state": "PENDING"}) {
+		emails[invite["email"].(string)] = struct{}{}
+	}
+
+	return emails
+}
+
+func hasNamespaceACL(userGroup simulator.Document, id string) bool {
+	acls, _ := userGroup["acls"].([]interface{})
+
+	for _, item := range acls {
+		acl, _ := item.(map[string]interface{})
+		if acl["resource"] != "kubenamespaces" {
+			continue
+		}
+
+		ids, _ := acl["resourceIds"].([]interface{})
+		for _, resourceID := range ids {
+			if resourceID == id {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// updateTenant applies the given change to the latest version of the Tenant.
+func updateTenant(ctx context.Context, t *testing.T, name string, fn func(tenant *capsulev1beta2.Tenant) error) {
+	t.Helper()
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		tenant := &capsulev1beta2.Tenant{}
+		if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, tenant); err != nil {
+			return err
+		}
+
+		return fn(tenant)
+	})
+	if err != nil {
+		t.Fatalf("cannot update Tenant %s: %s", name, err.Error())
+	}
+}
+
+func TestTenantLifecycle(t *testing.T) {
+	requireEnvTest(t)
+
+	ctx := context.Background()
+
+	tenant := &capsulev1beta2.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "oil",
+			Annotations: map[string]string{
+				annotations.ClusterIDAnnotation: testClusterID,
+			},
+		},
+		Spec: capsulev1beta2.TenantSpec{
+			Owners: capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.UserOwner, Name: "alice@clastix.io"},
+			},
+		},
+	}
+
+	t.Run("create", func(t *testing.T) {
+		if err := k8sClient.Create(ctx, tenant); err != nil {
+			t.Fatalf("cannot create Tenant: %s", err.Error())
+		}
+
+		eventually(t, func() error {
+			if _, err := userGroupOf(tenant.GetName()); err != nil {
+				return err
+			}
+
+			if _, ok := pendingInvites(tenant.GetName())["alice@clastix.io"]; !ok {
+				return fmt.Errorf("missing pending invitation for alice@clastix.io")
+			}
+
+			current := &capsulev1beta2.Tenant{}
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(tenant), current); err != nil {
+				return err
+			}
+
+			if !controllerutil.ContainsFinalizer(current, tenantFinalizer) {
+				return fmt.Errorf("missing finalizer %s", tenantFinalizer)
+			}
+
+			return nil
+		})
+	})
+
+	t.Run("owner change", func(t *testing.T) {
+		updateTenant(ctx, t, tenant.GetName(), func(tenant *capsulev1beta2.Tenant) error {
+			tenant.Spec.Owners = capsulev1beta2.OwnerListSpec{
+				{Kind: capsulev1beta2.UserOwner, Name: "bob@clastix.io"},
+			}
+
+			return k8sClient.Update(ctx, tenant)
+		})
+
+		eventually(t, func() error {
+			invites := pendingInvites(tenant.GetName())
+
+			if _, ok := invites["alice@clastix.io"]; ok {
+				return fmt.Errorf("pending invitation for the former owner alice@clastix.io has not been revoked")
+			}
+
+			if _, ok := invites["bob@clastix.io"]; !ok {
+				return fmt.Errorf("missing pending invitation for bob@clastix.io")
+			}
+
+			return nil
+		})
+	})
+
+	t.Run("invitation accepted", func(t *testing.T) {
+		cloudCasa.AcceptInvitation("bob@clastix.io")
+		// Triggering the reconciliation, the invitation acceptance is not notified
+		updateTenant(ctx, t, tenant.GetName(), func(tenant *capsulev1beta2.Tenant) error {
+			tenant.SetLabels(map[string]string{"envtest.clastix.io/trigger": "invitation"})
+
+			return k8sClient.Update(ctx, tenant)
+		})
+
+		users := cloudCasa.List(simulator.Users, simulator.Filter{"email": "bob@clastix.io"})
+		if len(users) != 1 {
+			t.Fatalf("expected the CloudCasa user for bob@clastix.io")
+		}
+
+		eventually(t, func() error {
+			userGroup, err := userGroupOf(tenant.GetName())
+			if err != nil {
+				return err
+			}
+
+			if !simulator.Filter(map[string]interface{}{"users": users[0]["_id"]}).Match(userGroup) {
+				return fmt.Errorf("bob@clastix.io is not a member of the UserGroup")
+			}
+
+			return nil
+		})
+	})
+
+	t.Run("namespace add", func(t *testing.T) {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "oil-production"}}
+		if err := k8sClient.Create(ctx, ns); err != nil {
+			t.Fatalf("cannot create Namespace: %s", err.Error())
+		}
+
+		id := cloudCasa.Insert(simulator.KubeNamespaces, simulator.Document{"name": ns.GetName(), "cluster_id": testClusterID, "k8s_uid": string(ns.GetUID())})
+		// The Tenant status is managed by Capsule, which is not running
+		updateTenant(ctx, t, tenant.GetName(), func(tenant *capsulev1beta2.Tenant) error {
+			tenant.Status.Namespaces = []string{ns.GetName()}
+			tenant.Status.Size = 1
+
+			return k8sClient.Status().Update(ctx, tenant)
+		})
+
+		eventually(t, func() error {
+			userGroup, err := userGroupOf(tenant.GetName())
+			if err != nil {
+				return err
+			}
+
+			if !hasNamespaceACL(userGroup, id) {
+				return fmt.Errorf("missing ACL for the Kubenamespace %s", id)
+			}
+
+			return nil
+		})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if err := k8sClient.Delete(ctx, tenant); err != nil {
+			t.Fatalf("cannot delete Tenant: %s", err.Error())
+		}
+
+		eventually(t, func() error {
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(tenant), &capsulev1beta2.Tenant{}); !k8serr.IsNotFound(err) {
+				return fmt.Errorf("Tenant has not been deleted yet")
+			}
+
+			if items := cloudCasa.List(simulator.UserGroups, simulator.Filter{"name": tenant.GetName()}); len(items) > 0 {
+				return fmt.Errorf("UserGroup has not been deleted")
+			}
+
+			if invites := pendingInvites(tenant.GetName()); len(invites) > 0 {
+				return fmt.Errorf("pending invitations have not been revoked")
+			}
+
+			return nil
+		})
+	})
+}
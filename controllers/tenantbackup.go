@@ -0,0 +1,264 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/transport"
+)
+
+const (
+	AcceptedCondition  = "Accepted"
+	CompletedCondition = "Completed"
+	// tenantBackupTag is the Kubebackup tag storing the UID of the TenantBackup it has been created for.
+	tenantBackupTag = "capsule-clastix-io-tenantbackup"
+	// maxCloudCasaNameLength is the maximum length of the CloudCasa resource names.
+	maxCloudCasaNameLength = 128
+)
+
+// TenantBackup backs up on demand the Namespaces of a Tenant by means of an ADHOC CloudCasa Kubebackup,
+// mirroring the state of the CloudCasa job in the TenantBackup status.
+type TenantBackup struct {
+	// DefaultClusterID is the CloudCasa cluster ID of the Tenants without the cluster ID annotation, if any.
+	DefaultClusterID string
+
+	client       client.Client
+	recorder     record.EventRecorder
+	capsuleLabel string
+	cloudCasa    cloudcasa.Service
+	extractor    annotations.Annotations
+}
+
+func (r *TenantBackup) SetupWithManager(cc cloudcasa.Service, mgr manager.Manager) error {
+	capsuleLabel, err := capsulev1beta2.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		return err
+	}
+
+	r.capsuleLabel = capsuleLabel
+	r.cloudCasa = cc
+	r.extractor = &annotations.Extractor{DefaultClusterID: r.DefaultClusterID}
+	r.recorder = mgr.GetEventRecorderFor("capsule-addon-cloudcasa")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cloudcasav1alpha1.TenantBackup{}).
+		Complete(r)
+}
+
+func (r *TenantBackup) InjectClient(client client.Client) error {
+	r.client = client
+
+	return nil
+}
+
+func (r *TenantBackup) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, err error) {
+	backup := &cloudcasav1alpha1.TenantBackup{}
+	if err = r.client.Get(ctx, request.NamespacedName, backup); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, err
+	}
+	// The backup has been already completed, or refused
+	if meta.FindStatusCondition(backup.Status.Conditions, CompletedCondition) != nil || meta.IsStatusConditionFalse(backup.Status.Conditions, AcceptedCondition) {
+		return reconcile.Result{}, nil
+	}
+
+	patch := client.MergeFrom(backup.DeepCopy())
+
+	defer func() {
+		if statusErr := r.client.Status().Patch(ctx, backup, patch); statusErr != nil && err == nil {
+			err = goerr.Wrap(statusErr, "cannot update TenantBackup status")
+		}
+	}()
+
+	if len(backup.Status.BackupID) == 0 {
+		if accepted, backupErr := r.ensureBackup(ctx, backup); !accepted {
+			return reconcile.Result{}, backupErr
+		}
+	}
+
+	return r.ensureJob(ctx, backup)
+}
+
+// ensureBackup creates the ADHOC Kubebackup for the TenantBackup, returning false if the backup has been refused,
+// or it cannot be created.
+func (r *TenantBackup) ensureBackup(ctx context.Context, backup *cloudcasav1alpha1.TenantBackup) (bool, error) {
+	refuse := func(reason string, err error) (bool, error) {
+		r.setCondition(backup, AcceptedCondition, metav1.ConditionFalse, reason, err.Error())
+
+		return false, nil
+	}
+
+	tenant, err := namespaceTenant(ctx, r.client, r.capsuleLabel, backup.GetNamespace())
+	switch {
+	case isTenantNotFound(err):
+		return refuse("TenantNotFound", fmt.Errorf("cannot retrieve the Tenant of Namespace %s: %w", backup.GetNamespace(), err))
+	case err != nil:
+		return false, goerr.Wrap(err, "cannot retrieve the Tenant of the TenantBackup")
+	}
+
+	namespaces := backup.Spec.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = tenant.Status.Namespaces
+	}
+
+	if outside := outsideTenant(tenant, namespaces); len(outside) > 0 {
+		return refuse("NamespacesOutsideTenant", fmt.Errorf("Namespaces not belonging to Tenant %s cannot be backed up: %s", tenant.GetName(), strings.Join(outside, ", ")))
+	}
+
+	clusterID, ok := r.extractor.ClusterID(tenant)
+	if !ok {
+		return refuse("ClusterIDMissing", fmt.Errorf("missing CloudCasa Cluster ID for Tenant %s", tenant.GetName()))
+	}
+
+	kubeBackup := oapi.Kubebackup{
+		Cluster: oapi.KubeclusterId(clusterID),
+		Name:    cloudCasaName(backup.GetNamespace(), backup.GetName(), string(backup.GetUID())),
+		Tags: &map[string]interface{}{
+			tenantTag:       tenant.GetName(),
+			tenantBackupTag: string(backup.GetUID()),
+		},
+	}
+
+	triggerType := oapi.KubebackupTriggerTypeADHOC
+	kubeBackup.TriggerType = &triggerType
+	kubeBackup.Source.Namespaces = &namespaces
+	kubeBackup.Source.SnapshotPersistentVolumes = backup.Spec.SnapshotPersistentVolumes
+
+	created, err := r.cloudCasa.EnsureBackup(ctx, kubeBackup)
+	if err != nil {
+		r.setCondition(backup, AcceptedCondition, metav1.ConditionUnknown, "BackupNotCreated", err.Error())
+
+		return false, goerr.Wrap(err, "cannot create CloudCasa Kubebackup")
+	}
+
+	backup.Status.Tenant = tenant.GetName()
+	backup.Status.Namespaces = namespaces
+	backup.Status.BackupID = string(*created.Id)
+
+	r.setCondition(backup, AcceptedCondition, metav1.ConditionTrue, "BackupCreated", fmt.Sprintf("CloudCasa Kubebackup %s has been created", created.Name))
+
+	return true, nil
+}
+
+// ensureJob runs the Kubebackup, if not yet done, and mirrors the state of the CloudCasa job:
+// the job is scheduled asynchronously, thus it's looked up by the run name until available.
+func (r *TenantBackup) ensureJob(ctx context.Context, backup *cloudcasav1alpha1.TenantBackup) (reconcile.Result, error) {
+	var job *oapi.Job
+
+	var err error
+
+	filter := cloudcasa.JobFilter{Name: cloudCasaName(backup.GetNamespace(), backup.GetName(), string(backup.GetUID())), BackupID: backup.Status.BackupID}
+
+	switch {
+	case backup.Status.Job == nil:
+		// The run is recorded before being requested, otherwise a failed status update would run the Kubebackup again
+		if err = r.patchJob(ctx, backup, &cloudcasav1alpha1.JobStatus{State: string(oapi.JobStatePENDING)}); err != nil {
+			return reconcile.Result{}, goerr.Wrap(err, "cannot record the CloudCasa Kubebackup run")
+		}
+
+		if err = r.cloudCasa.RunBackup(ctx, backup.Status.BackupID, filter.Name, backup.Spec.RetentionDays); err != nil {
+			// The run can be requested again only if it has not been performed, otherwise its job is looked up
+			if cloudcasa.IsRefused(err) || goerr.Is(err, transport.ErrCircuitOpen) {
+				if patchErr := r.patchJob(ctx, backup, nil); patchErr != nil {
+					return reconcile.Result{}, goerr.Wrap(patchErr, "cannot reset the CloudCasa Kubebackup run")
+				}
+			}
+
+			return reconcile.Result{}, goerr.Wrap(err, "cannot run CloudCasa Kubebackup")
+		}
+
+		return reconcile.Result{RequeueAfter: jobPollInterval}, nil
+	case len(backup.Status.Job.ID) == 0:
+		if job, err = r.cloudCasa.FindLatestJob(ctx, filter); err != nil || job == nil {
+			return reconcile.Result{RequeueAfter: jobPollInterval}, err
+		}
+	default:
+		if job, err = r.cloudCasa.GetJob(ctx, backup.Status.Job.ID); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	backup.Status.Job = jobStatus(job)
+
+	if !isJobFinished(backup.Status.Job.State) {
+		return reconcile.Result{RequeueAfter: jobPollInterval}, nil
+	}
+
+	status, message := metav1.ConditionTrue, "CloudCasa backup has been completed"
+	if !isJobSucceeded(backup.Status.Job.State) {
+		status, message = metav1.ConditionFalse, fmt.Sprintf("CloudCasa backup has not been completed: %s", backup.Status.Job.Message)
+	}
+
+	r.setCondition(backup, CompletedCondition, status, jobReason(backup.Status.Job.State), message)
+
+	return reconcile.Result{}, nil
+}
+
+// patchJob updates right away the job status only, the other status changes being updated upon the reconciliation end.
+func (r *TenantBackup) patchJob(ctx context.Context, backup *cloudcasav1alpha1.TenantBackup, job *cloudcasav1alpha1.JobStatus) error {
+	updated := backup.DeepCopy()
+	updated.Status.Job = job
+
+	if err := r.client.Status().Patch(ctx, updated, client.MergeFrom(backup)); err != nil {
+		return err
+	}
+
+	backup.Status.Job = job
+
+	return nil
+}
+
+func (r *TenantBackup) setCondition(backup *cloudcasav1alpha1.TenantBackup, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	eventType := corev1.EventTypeNormal
+	if status != metav1.ConditionTrue {
+		eventType = corev1.EventTypeWarning
+	}
+
+	r.recorder.Event(backup, eventType, reason, message)
+
+	meta.SetStatusCondition(&backup.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: backup.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// cloudCasaName returns a CloudCasa resource name unique for the given object, within the allowed length.
+func cloudCasaName(namespace, name, uid string) string {
+	suffix := uid
+	if len(suffix) > 8 {
+		suffix = suffix[:8]
+	}
+
+	prefix := fmt.Sprintf("%s-%s", namespace, name)
+	if max := maxCloudCasaNameLength - len(suffix) - 1; len(prefix) > max {
+		prefix = prefix[:max]
+	}
+
+	return fmt.Sprintf("%s-%s", prefix, suffix)
+}
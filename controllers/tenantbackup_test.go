@@ -0,0 +1,239 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/transport"
+)
+
+func TestCloudCasaName(t *testing.T) {
+	testCases := []struct {
+		name      string
+		namespace string
+		uid       string
+		expected  string
+	}{
+		{name: "nightly", namespace: "oil-production", uid: "5f3c1e2a-9b8d-4c7e-a6f5-0d1e2f3a4b5c", expected: "oil-production-nightly-5f3c1e2a"},
+		{name: "nightly", namespace: "oil-production", uid: "5f3c", expected: "oil-production-nightly-5f3c"},
+		{name: strings.Repeat("n", 63), namespace: strings.Repeat("o", 63), uid: "5f3c1e2a-9b8d-4c7e-a6f5-0d1e2f3a4b5c", expected: strings.Repeat("o", 63) + "-" + strings.Repeat("n", 55) + "-5f3c1e2a"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			name := cloudCasaName(tc.namespace, tc.name, tc.uid)
+			if name != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, name)
+			}
+
+			if len(name) > maxCloudCasaNameLength {
+				t.Errorf("expected at most %d characters, got %d", maxCloudCasaNameLength, len(name))
+			}
+		})
+	}
+}
+
+func newTenantBackupReconciler(t *testing.T, service cloudcasa.Service, objects ...client.Object) *TenantBackup {
+	t.Helper()
+
+	capsuleLabel, err := capsulev1beta2.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		t.Fatalf("cannot retrieve the Capsule Tenant label: %s", err.Error())
+	}
+
+	return &TenantBackup{
+		client:       newFakeClient(objects...),
+		recorder:     record.NewFakeRecorder(100),
+		capsuleLabel: capsuleLabel,
+		cloudCasa:    service,
+		extractor:    &annotations.Extractor{},
+	}
+}
+
+// reconcileTenantBackup reconciles the TenantBackup, returning its updated version.
+func reconcileTenantBackup(ctx context.Context, t *testing.T, r *TenantBackup, backup *cloudcasav1alpha1.TenantBackup) (*cloudcasav1alpha1.TenantBackup, reconcile.Result, error) {
+	t.Helper()
+
+	result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(backup)})
+
+	current := &cloudcasav1alpha1.TenantBackup{}
+	if getErr := r.client.Get(ctx, client.ObjectKeyFromObject(backup), current); getErr != nil {
+		t.Fatalf("cannot retrieve TenantBackup: %s", getErr.Error())
+	}
+
+	return current, result, err
+}
+
+func TestTenantBackupRefusal(t *testing.T) {
+	testCases := []struct {
+		name              string
+		namespace         string
+		namespaces        []string
+		tenantAnnotations map[string]string
+		reason            string
+	}{
+		{
+			name:              "Namespace without Tenant",
+			namespace:         "default",
+			tenantAnnotations: map[string]string{annotations.ClusterIDAnnotation: testClusterID},
+			reason:            "TenantNotFound",
+		},
+		{
+			name:              "Namespaces outside the Tenant",
+			namespace:         "oil-production",
+			namespaces:        []string{"oil-production", "gas-production"},
+			tenantAnnotations: map[string]string{annotations.ClusterIDAnnotation: testClusterID},
+			reason:            "NamespacesOutsideTenant",
+		},
+		{
+			name:      "missing cluster ID",
+			namespace: "oil-production",
+			reason:    "ClusterIDMissing",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			backup := &cloudcasav1alpha1.TenantBackup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tc.namespace, Name: "nightly", UID: "5f3c1e2a-9b8d-4c7e-a6f5-0d1e2f3a4b5c"},
+				Spec:       cloudcasav1alpha1.TenantBackupSpec{Namespaces: tc.namespaces},
+			}
+
+			objects := append(tenantObjects(t, "oil", tc.tenantAnnotations, "oil-production"), tenantObjects(t, "gas", nil, "gas-production")...)
+			objects = append(objects, backup)
+
+			service := fake.New()
+			r := newTenantBackupReconciler(t, service, objects...)
+
+			current, _, err := reconcileTenantBackup(ctx, t, r, backup)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			accepted := meta.FindStatusCondition(current.Status.Conditions, AcceptedCondition)
+			if accepted == nil || accepted.Status != metav1.ConditionFalse || accepted.Reason != tc.reason {
+				t.Fatalf("expected the TenantBackup to be refused with reason %s, got %+v", tc.reason, accepted)
+			}
+
+			if backups, _ := service.ListBackups(ctx, cloudcasa.BackupFilter{}); len(backups) > 0 {
+				t.Errorf("expected no CloudCasa Kubebackups, got %d", len(backups))
+			}
+		})
+	}
+}
+
+func TestTenantBackupRun(t *testing.T) {
+	ctx := context.Background()
+
+	backup := &cloudcasav1alpha1.TenantBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "oil-production", Name: "nightly", UID: "5f3c1e2a-9b8d-4c7e-a6f5-0d1e2f3a4b5c"},
+		Spec:       cloudcasav1alpha1.TenantBackupSpec{RetentionDays: 7},
+	}
+
+	objects := append(tenantObjects(t, "oil", map[string]string{annotations.ClusterIDAnnotation: testClusterID}, "oil-production", "oil-development"), backup)
+
+	service := &faultyService{Service: fake.New()}
+	r := newTenantBackupReconciler(t, service, objects...)
+
+	current, result, err := reconcileTenantBackup(ctx, t, r, backup)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !meta.IsStatusConditionTrue(current.Status.Conditions, AcceptedCondition) || len(current.Status.BackupID) == 0 {
+		t.Fatalf("expected the TenantBackup to be accepted, got %+v", current.Status)
+	}
+	// The run is recorded, while its job is still unknown
+	if current.Status.Job == nil || current.Status.Job.State != string(oapi.JobStatePENDING) || len(current.Status.Job.ID) > 0 || result.RequeueAfter == 0 {
+		t.Fatalf("expected a pending job, got %+v", current.Status.Job)
+	}
+
+	kubeBackup, err := service.GetBackup(ctx, current.Status.BackupID)
+	if err != nil {
+		t.Fatalf("cannot retrieve CloudCasa Kubebackup: %s", err.Error())
+	}
+
+	if kubeBackup.Source.Namespaces == nil || len(*kubeBackup.Source.Namespaces) != 2 {
+		t.Errorf("expected the Tenant Namespaces to be backed up, got %v", kubeBackup.Source.Namespaces)
+	}
+
+	if current, _, err = reconcileTenantBackup(ctx, t, r, backup); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if current.Status.Job == nil || len(current.Status.Job.ID) == 0 || current.Status.Job.State != string(oapi.JobStateRUNNING) {
+		t.Fatalf("expected the running job to be found, got %+v", current.Status.Job)
+	}
+	// The Kubebackup is run once, its job being looked up afterwards
+	if service.runBackupCalls != 1 {
+		t.Fatalf("expected the Kubebackup to be run once, got %d runs", service.runBackupCalls)
+	}
+
+	service.SetJobState(current.Status.Job.ID, oapi.JobStateCOMPLETED)
+
+	if current, result, err = reconcileTenantBackup(ctx, t, r, backup); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !meta.IsStatusConditionTrue(current.Status.Conditions, CompletedCondition) || result.RequeueAfter > 0 {
+		t.Errorf("expected the TenantBackup to be completed, got %+v", current.Status.Conditions)
+	}
+}
+
+func TestTenantBackupRunFailure(t *testing.T) {
+	testCases := []struct {
+		name  string
+		err   error
+		reset bool
+	}{
+		{name: "refused run", err: &cloudcasa.APIError{StatusCode: http.StatusBadRequest, Code: http.StatusBadRequest}, reset: true},
+		{name: "open circuit", err: transport.ErrCircuitOpen, reset: true},
+		{name: "server error", err: &cloudcasa.APIError{StatusCode: http.StatusBadGateway, Code: http.StatusBadGateway}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			backup := &cloudcasav1alpha1.TenantBackup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "oil-production", Name: "nightly", UID: "5f3c1e2a-9b8d-4c7e-a6f5-0d1e2f3a4b5c"},
+			}
+
+			objects := append(tenantObjects(t, "oil", map[string]string{annotations.ClusterIDAnnotation: testClusterID}, "oil-production"), backup)
+
+			r := newTenantBackupReconciler(t, &faultyService{Service: fake.New(), runBackupErr: tc.err}, objects...)
+
+			current, _, err := reconcileTenantBackup(ctx, t, r, backup)
+			if err == nil {
+				t.Fatal("expected the run error to be returned")
+			}
+			// A run not performed is requested again, otherwise its job is looked up
+			if reset := current.Status.Job == nil; reset != tc.reset {
+				t.Errorf("expected the run marker reset to be %t, got job %+v", tc.reset, current.Status.Job)
+			}
+
+			if len(current.Status.BackupID) == 0 {
+				t.Error("expected the Kubebackup ID to be retained")
+			}
+		})
+	}
+}
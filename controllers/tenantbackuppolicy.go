@@ -0,0 +1,245 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+)
+
+const (
+	ReadyCondition = "Ready"
+	// tenantBackupPolicyTag is the Policy and Kubebackup tag storing the UID of the TenantBackupPolicy they have been created for.
+	tenantBackupPolicyTag = "capsule-clastix-io-tenantbackuppolicy"
+)
+
+// TenantBackupPolicy backs up the Namespaces of a Tenant on schedule by means of a CloudCasa Policy and a SCHEDULED
+// Kubebackup, whose Namespaces follow the ones of the Tenant.
+type TenantBackupPolicy struct {
+	// DefaultClusterID is the CloudCasa cluster ID of the Tenants without the cluster ID annotation, if any.
+	DefaultClusterID string
+
+	client       client.Client
+	recorder     record.EventRecorder
+	capsuleLabel string
+	cloudCasa    cloudcasa.Service
+	extractor    annotations.Annotations
+}
+
+func (r *TenantBackupPolicy) SetupWithManager(cc cloudcasa.Service, mgr manager.Manager) error {
+	capsuleLabel, err := capsulev1beta2.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		return err
+	}
+
+	r.capsuleLabel = capsuleLabel
+	r.cloudCasa = cc
+	r.extractor = &annotations.Extractor{DefaultClusterID: r.DefaultClusterID}
+	r.recorder = mgr.GetEventRecorderFor("capsule-addon-cloudcasa")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cloudcasav1alpha1.TenantBackupPolicy{}).
+		Watches(&source.Kind{Type: &capsulev1beta2.Tenant{}}, handler.EnqueueRequestsFromMapFunc(r.enqueueTenantPolicies)).
+		Complete(r)
+}
+
+func (r *TenantBackupPolicy) InjectClient(client client.Client) error {
+	r.client = client
+
+	return nil
+}
+
+func (r *TenantBackupPolicy) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, err error) {
+	policy := &cloudcasav1alpha1.TenantBackupPolicy{}
+	if err = r.client.Get(ctx, request.NamespacedName, policy); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, err
+	}
+
+	if !policy.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, r.finalize(ctx, policy)
+	}
+
+	if !controllerutil.ContainsFinalizer(policy, tenantFinalizer) {
+		controllerutil.AddFinalizer(policy, tenantFinalizer)
+
+		return reconcile.Result{}, r.client.Update(ctx, policy)
+	}
+
+	patch := client.MergeFrom(policy.DeepCopy())
+
+	defer func() {
+		if statusErr := r.client.Status().Patch(ctx, policy, patch); statusErr != nil && err == nil {
+			err = goerr.Wrap(statusErr, "cannot update TenantBackupPolicy status")
+		}
+	}()
+
+	return reconcile.Result{}, r.ensureScheduledBackup(ctx, policy)
+}
+
+// ensureScheduledBackup reconciles the CloudCasa Policy and Kubebackup of the TenantBackupPolicy with the current
+// Tenant Namespaces: refusals are reported in the Ready condition, waiting for the TenantBackupPolicy or Tenant changes.
+func (r *TenantBackupPolicy) ensureScheduledBackup(ctx context.Context, policy *cloudcasav1alpha1.TenantBackupPolicy) error {
+	tenant, err := namespaceTenant(ctx, r.client, r.capsuleLabel, policy.GetNamespace())
+	switch {
+	case isTenantNotFound(err):
+		r.setCondition(policy, metav1.ConditionFalse, "TenantNotFound", fmt.Sprintf("cannot retrieve the Tenant of Namespace %s: %s", policy.GetNamespace(), err.Error()))
+
+		return nil
+	case err != nil:
+		return goerr.Wrap(err, "cannot retrieve the Tenant of the TenantBackupPolicy")
+	}
+
+	clusterID, ok := r.extractor.ClusterID(tenant)
+	if !ok {
+		r.setCondition(policy, metav1.ConditionFalse, "ClusterIDMissing", fmt.Sprintf("missing CloudCasa Cluster ID for Tenant %s", tenant.GetName()))
+
+		return nil
+	}
+
+	for _, schedule := range policy.Spec.Schedules {
+		if _, err = cronFields(schedule.Cron); err != nil {
+			r.setCondition(policy, metav1.ConditionFalse, "InvalidSchedule", err.Error())
+
+			return nil
+		}
+	}
+
+	namespaces := policy.Spec.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = tenant.Status.Namespaces
+	}
+	// Namespaces could leave the Tenant at any time, thus these are excluded rather than refused
+	outside := outsideTenant(tenant, namespaces)
+	namespaces = sets.NewString(namespaces...).Delete(outside...).List()
+
+	policyID, backupID, err := ensureScheduledBackup(ctx, r.cloudCasa, scheduledBackup{
+		name:      cloudCasaName(policy.GetNamespace(), policy.GetName(), string(policy.GetUID())),
+		clusterID: clusterID,
+		tags: map[string]interface{}{
+			tenantTag:             tenant.GetName(),
+			tenantBackupPolicyTag: string(policy.GetUID()),
+		},
+		namespaces:                namespaces,
+		timezone:                  policy.Spec.Timezone,
+		schedules:                 policy.Spec.Schedules,
+		snapshotPersistentVolumes: policy.Spec.SnapshotPersistentVolumes,
+	})
+
+	if len(policyID) > 0 {
+		policy.Status.PolicyID = policyID
+	}
+
+	if len(backupID) > 0 {
+		policy.Status.BackupID = backupID
+	}
+
+	if err != nil {
+		r.setCondition(policy, metav1.ConditionUnknown, "PolicyNotReconciled", err.Error())
+
+		return err
+	}
+
+	policy.Status.Tenant = tenant.GetName()
+	policy.Status.Namespaces = namespaces
+
+	if len(namespaces) == 0 {
+		r.setCondition(policy, metav1.ConditionFalse, "NoNamespaces", "CloudCasa Kubebackup has been paused since no Namespaces of the Tenant are selected")
+
+		return nil
+	}
+
+	message := fmt.Sprintf("CloudCasa Kubebackup is scheduled for %d Namespaces", len(namespaces))
+	if len(outside) > 0 {
+		message = fmt.Sprintf("%s, excluding the ones not belonging to Tenant %s: %s", message, tenant.GetName(), strings.Join(outside, ", "))
+	}
+
+	r.setCondition(policy, metav1.ConditionTrue, "Scheduled", message)
+
+	return nil
+}
+
+// finalize removes the CloudCasa Kubebackup and Policy of the deleted TenantBackupPolicy:
+// the backups already taken are retained according to their retention.
+func (r *TenantBackupPolicy) finalize(ctx context.Context, policy *cloudcasav1alpha1.TenantBackupPolicy) error {
+	if !controllerutil.ContainsFinalizer(policy, tenantFinalizer) {
+		return nil
+	}
+
+	if err := deleteScheduledBackup(ctx, r.cloudCasa, policy.Status.PolicyID, policy.Status.BackupID); err != nil {
+		return err
+	}
+
+	controllerutil.RemoveFinalizer(policy, tenantFinalizer)
+
+	return r.client.Update(ctx, policy)
+}
+
+// setCondition sets the Ready condition, recording an event only upon changes since the policy is reconciled
+// upon every Tenant change.
+func (r *TenantBackupPolicy) setCondition(policy *cloudcasav1alpha1.TenantBackupPolicy, status metav1.ConditionStatus, reason, message string) {
+	if current := meta.FindStatusCondition(policy.Status.Conditions, ReadyCondition); current == nil || current.Status != status || current.Reason != reason || current.Message != message {
+		eventType := corev1.EventTypeNormal
+		if status != metav1.ConditionTrue {
+			eventType = corev1.EventTypeWarning
+		}
+
+		r.recorder.Event(policy, eventType, reason, message)
+	}
+
+	meta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+		Type:               ReadyCondition,
+		Status:             status,
+		ObservedGeneration: policy.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// enqueueTenantPolicies enqueues the TenantBackupPolicies of the Tenant, whose Namespaces could have been changed.
+func (r *TenantBackupPolicy) enqueueTenantPolicies(object client.Object) (requests []reconcile.Request) {
+	tenant, ok := object.(*capsulev1beta2.Tenant)
+	if !ok {
+		return nil
+	}
+
+	for _, namespace := range tenant.Status.Namespaces {
+		policies := &cloudcasav1alpha1.TenantBackupPolicyList{}
+
+		if err := r.client.List(context.Background(), policies, client.InNamespace(namespace)); err != nil {
+			continue
+		}
+
+		for _, policy := range policies.Items {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: policy.GetNamespace(), Name: policy.GetName()}})
+		}
+	}
+
+	return requests
+}
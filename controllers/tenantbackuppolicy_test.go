@@ -0,0 +1,248 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+)
+
+// unavailableTenants fails the retrieval of the Tenants, as upon API server unavailability.
+type unavailableTenants struct {
+	client.Client
+}
+
+func (c unavailableTenants) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	if _, ok := obj.(*capsulev1beta2.Tenant); ok {
+		return errors.NewServiceUnavailable("the server is currently unable to handle the request")
+	}
+
+	return c.Client.Get(ctx, key, obj)
+}
+
+func newTenantBackupPolicyReconciler(t *testing.T, c client.Client, service *fake.Service) *TenantBackupPolicy {
+	t.Helper()
+
+	capsuleLabel, err := capsulev1beta2.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		t.Fatalf("cannot retrieve the Capsule Tenant label: %s", err.Error())
+	}
+
+	return &TenantBackupPolicy{
+		client:       c,
+		recorder:     record.NewFakeRecorder(100),
+		capsuleLabel: capsuleLabel,
+		cloudCasa:    service,
+		extractor:    &annotations.Extractor{},
+	}
+}
+
+func TestTenantBackupPolicyReconcile(t *testing.T) {
+	daily := []cloudcasav1alpha1.BackupSchedule{{Cron: "0 2 * * *", RetentionDays: 7}}
+
+	testCases := []struct {
+		name              string
+		namespace         string
+		tenantAnnotations map[string]string
+		spec              cloudcasav1alpha1.TenantBackupPolicySpec
+		status            metav1.ConditionStatus
+		reason            string
+		namespaces        []string
+		paused            bool
+	}{
+		{
+			name:       "all the Tenant Namespaces",
+			spec:       cloudcasav1alpha1.TenantBackupPolicySpec{Schedules: daily},
+			status:     metav1.ConditionTrue,
+			reason:     "Scheduled",
+			namespaces: []string{"oil-development", "oil-production"},
+		},
+		{
+			name:       "Namespaces outside the Tenant are excluded",
+			spec:       cloudcasav1alpha1.TenantBackupPolicySpec{Schedules: daily, Namespaces: []string{"oil-production", "gas-production"}},
+			status:     metav1.ConditionTrue,
+			reason:     "Scheduled",
+			namespaces: []string{"oil-production"},
+		},
+		{
+			name:   "paused without Tenant Namespaces",
+			spec:   cloudcasav1alpha1.TenantBackupPolicySpec{Schedules: daily, Namespaces: []string{"gas-production"}},
+			status: metav1.ConditionFalse,
+			reason: "NoNamespaces",
+			paused: true,
+		},
+		{
+			name:   "invalid schedule",
+			spec:   cloudcasav1alpha1.TenantBackupPolicySpec{Schedules: []cloudcasav1alpha1.BackupSchedule{{Cron: "@daily", RetentionDays: 7}}},
+			status: metav1.ConditionFalse,
+			reason: "InvalidSchedule",
+		},
+		{
+			name:      "Namespace without Tenant",
+			namespace: "default",
+			spec:      cloudcasav1alpha1.TenantBackupPolicySpec{Schedules: daily},
+			status:    metav1.ConditionFalse,
+			reason:    "TenantNotFound",
+		},
+		{
+			name:              "missing cluster ID",
+			tenantAnnotations: map[string]string{},
+			spec:              cloudcasav1alpha1.TenantBackupPolicySpec{Schedules: daily},
+			status:            metav1.ConditionFalse,
+			reason:            "ClusterIDMissing",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			namespace := tc.namespace
+			if len(namespace) == 0 {
+				namespace = "oil-production"
+			}
+
+			tenantAnnotations := tc.tenantAnnotations
+			if tenantAnnotations == nil {
+				tenantAnnotations = map[string]string{annotations.ClusterIDAnnotation: testClusterID}
+			}
+
+			policy := &cloudcasav1alpha1.TenantBackupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "nightly", UID: "2c4e6a8b-1d3f-4b5a-9c7e-0f2a4c6e8a0b"},
+				Spec:       tc.spec,
+			}
+
+			objects := append(tenantObjects(t, "oil", tenantAnnotations, "oil-production", "oil-development"), tenantObjects(t, "gas", nil, "gas-production")...)
+			objects = append(objects, policy)
+
+			service := fake.New()
+			r := newTenantBackupPolicyReconciler(t, newFakeClient(objects...), service)
+			// The first reconciliation adds the finalizer
+			for i := 0; i < 2; i++ {
+				if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)}); err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+			}
+
+			current := &cloudcasav1alpha1.TenantBackupPolicy{}
+			if err := r.client.Get(ctx, client.ObjectKeyFromObject(policy), current); err != nil {
+				t.Fatalf("cannot retrieve TenantBackupPolicy: %s", err.Error())
+			}
+
+			ready := meta.FindStatusCondition(current.Status.Conditions, ReadyCondition)
+			if ready == nil || ready.Status != tc.status || ready.Reason != tc.reason {
+				t.Fatalf("expected the Ready condition to be %s with reason %s, got %+v", tc.status, tc.reason, ready)
+			}
+
+			if len(current.Status.BackupID) == 0 {
+				if len(tc.namespaces) > 0 || tc.paused {
+					t.Fatal("expected the CloudCasa Kubebackup to be created")
+				}
+
+				return
+			}
+
+			if !reflect.DeepEqual(current.Status.Namespaces, tc.namespaces) {
+				t.Errorf("expected Namespaces %v, got %v", tc.namespaces, current.Status.Namespaces)
+			}
+
+			backup, err := service.GetBackup(ctx, current.Status.BackupID)
+			if err != nil {
+				t.Fatalf("cannot retrieve CloudCasa Kubebackup: %s", err.Error())
+			}
+
+			if backup.Pause == nil || *backup.Pause != tc.paused {
+				t.Errorf("expected the CloudCasa Kubebackup pause to be %t", tc.paused)
+			}
+
+			if backup.Policy == nil || string(*backup.Policy) != current.Status.PolicyID {
+				t.Errorf("expected the CloudCasa Kubebackup to be run by Policy %s", current.Status.PolicyID)
+			}
+		})
+	}
+}
+
+func TestTenantBackupPolicyTransientErrors(t *testing.T) {
+	ctx := context.Background()
+
+	policy := &cloudcasav1alpha1.TenantBackupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "oil-production", Name: "nightly", UID: "2c4e6a8b-1d3f-4b5a-9c7e-0f2a4c6e8a0b", Finalizers: []string{tenantFinalizer}},
+		Spec:       cloudcasav1alpha1.TenantBackupPolicySpec{Schedules: []cloudcasav1alpha1.BackupSchedule{{Cron: "0 2 * * *", RetentionDays: 7}}},
+	}
+
+	objects := append(tenantObjects(t, "oil", map[string]string{annotations.ClusterIDAnnotation: testClusterID}, "oil-production"), policy)
+
+	r := newTenantBackupPolicyReconciler(t, unavailableTenants{Client: newFakeClient(objects...)}, fake.New())
+
+	if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)}); !errors.IsServiceUnavailable(err) {
+		t.Fatalf("expected the Tenant lookup error to be returned, got %v", err)
+	}
+
+	current := &cloudcasav1alpha1.TenantBackupPolicy{}
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(policy), current); err != nil {
+		t.Fatalf("cannot retrieve TenantBackupPolicy: %s", err.Error())
+	}
+
+	if ready := meta.FindStatusCondition(current.Status.Conditions, ReadyCondition); ready != nil {
+		t.Errorf("expected the Ready condition to be unset, got %+v", ready)
+	}
+}
+
+func TestTenantBackupPolicyFinalize(t *testing.T) {
+	ctx := context.Background()
+
+	policy := &cloudcasav1alpha1.TenantBackupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "oil-production", Name: "nightly", UID: "2c4e6a8b-1d3f-4b5a-9c7e-0f2a4c6e8a0b", Finalizers: []string{tenantFinalizer}},
+		Spec:       cloudcasav1alpha1.TenantBackupPolicySpec{Schedules: []cloudcasav1alpha1.BackupSchedule{{Cron: "0 2 * * *", RetentionDays: 7}}},
+	}
+
+	objects := append(tenantObjects(t, "oil", map[string]string{annotations.ClusterIDAnnotation: testClusterID}, "oil-production"), policy)
+
+	service := fake.New()
+	r := newTenantBackupPolicyReconciler(t, newFakeClient(objects...), service)
+
+	if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	current := &cloudcasav1alpha1.TenantBackupPolicy{}
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(policy), current); err != nil {
+		t.Fatalf("cannot retrieve TenantBackupPolicy: %s", err.Error())
+	}
+
+	if len(current.Status.BackupID) == 0 || len(current.Status.PolicyID) == 0 {
+		t.Fatalf("expected the CloudCasa Kubebackup and Policy to be created, got %+v", current.Status)
+	}
+
+	if err := r.client.Delete(ctx, current); err != nil {
+		t.Fatalf("cannot delete TenantBackupPolicy: %s", err.Error())
+	}
+
+	if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)}); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if _, err := service.GetBackup(ctx, current.Status.BackupID); err == nil {
+		t.Error("expected the CloudCasa Kubebackup to be deleted")
+	}
+
+	deleted := &cloudcasav1alpha1.TenantBackupPolicy{}
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(policy), deleted); err == nil && controllerutil.ContainsFinalizer(deleted, tenantFinalizer) {
+		t.Error("expected the finalizer to be removed")
+	}
+}
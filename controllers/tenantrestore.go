@@ -0,0 +1,345 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	goerr "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// tenantRestoreTag is the Kuberestore tag storing the UID of the TenantRestore it has been created for.
+const tenantRestoreTag = "capsule-clastix-io-tenantrestore"
+
+// TenantRestore restores a CloudCasa backup of the Tenant into its Namespaces by means of a CloudCasa Kuberestore,
+// mirroring the state of the CloudCasa job in the TenantRestore status.
+type TenantRestore struct {
+	// DefaultClusterID is the CloudCasa cluster ID of the Tenants without the cluster ID annotation, if any.
+	DefaultClusterID string
+
+	client       client.Client
+	recorder     record.EventRecorder
+	capsuleLabel string
+	cloudCasa    cloudcasa.Service
+	extractor    annotations.Annotations
+}
+
+func (r *TenantRestore) SetupWithManager(cc cloudcasa.Service, mgr manager.Manager) error {
+	capsuleLabel, err := capsulev1beta2.GetTypeLabel(&capsulev1beta2.Tenant{})
+	if err != nil {
+		return err
+	}
+
+	r.capsuleLabel = capsuleLabel
+	r.cloudCasa = cc
+	r.extractor = &annotations.Extractor{DefaultClusterID: r.DefaultClusterID}
+	r.recorder = mgr.GetEventRecorderFor("capsule-addon-cloudcasa")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cloudcasav1alpha1.TenantRestore{}).
+		Complete(r)
+}
+
+func (r *TenantRestore) InjectClient(client client.Client) error {
+	r.client = client
+
+	return nil
+}
+
+func (r *TenantRestore) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, err error) {
+	restore := &cloudcasav1alpha1.TenantRestore{}
+	if err = r.client.Get(ctx, request.NamespacedName, restore); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, err
+	}
+	// The restore has been already completed, or refused
+	if meta.FindStatusCondition(restore.Status.Conditions, CompletedCondition) != nil || meta.IsStatusConditionFalse(restore.Status.Conditions, AcceptedCondition) {
+		return reconcile.Result{}, nil
+	}
+
+	patch := client.MergeFrom(restore.DeepCopy())
+
+	defer func() {
+		if statusErr := r.client.Status().Patch(ctx, restore, patch); statusErr != nil && err == nil {
+			err = goerr.Wrap(statusErr, "cannot update TenantRestore status")
+		}
+	}()
+
+	if len(restore.Status.RestoreID) == 0 {
+		if accepted, restoreErr := r.ensureRestore(ctx, restore); !accepted {
+			return reconcile.Result{}, restoreErr
+		}
+	}
+
+	return r.ensureJob(ctx, restore)
+}
+
+// ensureRestore validates the TenantRestore against the Tenant boundaries and creates the Kuberestore,
+// returning false if the restore has been refused, or it cannot be created.
+func (r *TenantRestore) ensureRestore(ctx context.Context, restore *cloudcasav1alpha1.TenantRestore) (bool, error) {
+	refuse := func(reason string, err error) (bool, error) {
+		r.setCondition(restore, AcceptedCondition, metav1.ConditionFalse, reason, err.Error())
+
+		return false, nil
+	}
+
+	tenant, err := namespaceTenant(ctx, r.client, r.capsuleLabel, restore.GetNamespace())
+	switch {
+	case isTenantNotFound(err):
+		return refuse("TenantNotFound", fmt.Errorf("cannot retrieve the Tenant of Namespace %s: %w", restore.GetNamespace(), err))
+	case err != nil:
+		return false, goerr.Wrap(err, "cannot retrieve the Tenant of the TenantRestore")
+	}
+
+	instance, err := r.cloudCasa.GetBackupInstance(ctx, restore.Spec.BackupInstanceID)
+	if err != nil {
+		if cloudcasa.IsNotFound(err) {
+			return refuse("BackupNotFound", fmt.Errorf("CloudCasa backup instance %s does not exist", restore.Spec.BackupInstanceID))
+		}
+
+		return false, goerr.Wrap(err, "cannot retrieve CloudCasa backup instance")
+	}
+
+	backedUp := backupNamespaces(instance)
+
+	sources := restore.Spec.Namespaces
+	if len(sources) == 0 {
+		sources = backedUp
+	}
+
+	if missing := sets.NewString(sources...).Difference(sets.NewString(backedUp...)); missing.Len() > 0 {
+		return refuse("NamespacesNotBackedUp", fmt.Errorf("Namespaces not included in the backup cannot be restored: %s", strings.Join(missing.List(), ", ")))
+	}
+
+	clusterID, ok := r.extractor.ClusterID(tenant)
+	if !ok {
+		return refuse("ClusterIDMissing", fmt.Errorf("missing CloudCasa Cluster ID for Tenant %s", tenant.GetName()))
+	}
+
+	owned, err := r.isTenantBackup(ctx, tenant, clusterID, instance, sources)
+	if err != nil {
+		return false, err
+	}
+
+	if !owned {
+		return refuse("BackupOutsideTenant", fmt.Errorf("CloudCasa backup instance %s does not belong to Tenant %s", restore.Spec.BackupInstanceID, tenant.GetName()))
+	}
+
+	if unknown := sets.StringKeySet(restore.Spec.NamespaceMapping).Difference(sets.NewString(sources...)); unknown.Len() > 0 {
+		return refuse("InvalidNamespaceMapping", fmt.Errorf("Namespaces not being restored cannot be mapped: %s", strings.Join(unknown.List(), ", ")))
+	}
+
+	targets := make([]string, 0, len(sources))
+
+	for _, source := range sources {
+		target := source
+		if mapped, ok := restore.Spec.NamespaceMapping[source]; ok && len(mapped) > 0 {
+			target = mapped
+		}
+
+		targets = append(targets, target)
+	}
+
+	missing, outside, err := r.targetNamespaces(ctx, tenant, targets)
+	if err != nil {
+		return false, err
+	}
+
+	if len(outside) > 0 {
+		return refuse("NamespacesOutsideTenant", fmt.Errorf("Namespaces not belonging to Tenant %s cannot be restored into: %s", tenant.GetName(), strings.Join(outside, ", ")))
+	}
+	// Namespaces are created by the Tenant owners only, enforcing the Capsule policies such as the Namespace quota
+	if len(missing) > 0 {
+		return refuse("NamespacesNotFound", fmt.Errorf("Namespaces must be created in Tenant %s before being restored into: %s", tenant.GetName(), strings.Join(missing, ", ")))
+	}
+
+	kubeRestore := oapi.Kuberestore{
+		BackupInst: oapi.BackupinstanceId(restore.Spec.BackupInstanceID),
+		Name:       cloudCasaName(restore.GetNamespace(), restore.GetName(), string(restore.GetUID())),
+		Tags: &map[string]interface{}{
+			tenantTag:        tenant.GetName(),
+			tenantRestoreTag: string(restore.GetUID()),
+		},
+	}
+
+	cluster := oapi.KubeclusterId(clusterID)
+	kubeRestore.Cluster = &cluster
+	kubeRestore.Selection.Namespaces = &sources
+	kubeRestore.Selection.RestorePersistentVolumes = restore.Spec.RestorePersistentVolumes
+
+	if len(restore.Spec.NamespaceMapping) > 0 {
+		mapping := make(map[string]interface{}, len(restore.Spec.NamespaceMapping))
+
+		for source, target := range restore.Spec.NamespaceMapping {
+			mapping[source] = target
+		}
+
+		kubeRestore.NamespacesMap = &mapping
+	}
+
+	created, err := r.cloudCasa.EnsureRestore(ctx, kubeRestore)
+	if err != nil {
+		r.setCondition(restore, AcceptedCondition, metav1.ConditionUnknown, "RestoreNotCreated", err.Error())
+
+		return false, goerr.Wrap(err, "cannot create CloudCasa Kuberestore")
+	}
+
+	restore.Status.Tenant = tenant.GetName()
+	restore.Status.Namespaces = targets
+	restore.Status.RestoreID = string(*created.Id)
+
+	r.setCondition(restore, AcceptedCondition, metav1.ConditionTrue, "RestoreCreated", fmt.Sprintf("CloudCasa Kuberestore %s has been created", created.Name))
+
+	return true, nil
+}
+
+// isTenantBackup returns true if the backup instance has been taken on the Tenant cluster, its restored Namespaces
+// belong to the Tenant, and neither the instance nor its Kubebackup have been tagged for a different Tenant:
+// the Namespaces of another cluster with the same names belong to other tenants.
+func (r *TenantRestore) isTenantBackup(ctx context.Context, tenant *capsulev1beta2.Tenant, clusterID string, instance *oapi.Backupinstance, namespaces []string) (bool, error) {
+	if instance.Cluster == nil || string(*instance.Cluster) != clusterID {
+		return false, nil
+	}
+
+	if len(namespaces) == 0 || len(outsideTenant(tenant, namespaces)) > 0 {
+		return false, nil
+	}
+
+	if instance.BackupSelection != nil && instance.BackupSelection.AllNamespaces != nil && *instance.BackupSelection.AllNamespaces {
+		return false, nil
+	}
+
+	foreign := func(tags *map[string]interface{}) bool {
+		if tags == nil {
+			return false
+		}
+
+		v, ok := (*tags)[tenantTag].(string)
+
+		return ok && v != tenant.GetName()
+	}
+
+	if foreign(instance.Tags) {
+		return false, nil
+	}
+
+	if instance.Backupdef != nil {
+		backup, err := r.cloudCasa.GetBackup(ctx, string(*instance.Backupdef))
+
+		switch {
+		case err == nil:
+			return !foreign(backup.Tags), nil
+		case !cloudcasa.IsNotFound(err):
+			return false, goerr.Wrap(err, "cannot retrieve CloudCasa Kubebackup")
+		}
+	}
+
+	return true, nil
+}
+
+// targetNamespaces returns the target Namespaces not existing yet, and the ones not belonging to the Tenant.
+func (r *TenantRestore) targetNamespaces(ctx context.Context, tenant *capsulev1beta2.Tenant, targets []string) (missing, outside []string, err error) {
+	for _, target := range targets {
+		ns := &corev1.Namespace{}
+
+		if err = r.client.Get(ctx, types.NamespacedName{Name: target}, ns); err != nil {
+			if errors.IsNotFound(err) {
+				missing = append(missing, target)
+
+				continue
+			}
+
+			return nil, nil, err
+		}
+
+		if ns.GetLabels()[r.capsuleLabel] != tenant.GetName() {
+			outside = append(outside, target)
+		}
+	}
+
+	return missing, outside, nil
+}
+
+// ensureJob mirrors the state of the CloudCasa job started by the Kuberestore creation:
+// the job is scheduled asynchronously, thus it's looked up until available.
+func (r *TenantRestore) ensureJob(ctx context.Context, restore *cloudcasav1alpha1.TenantRestore) (reconcile.Result, error) {
+	var job *oapi.Job
+
+	var err error
+
+	if restore.Status.Job == nil || len(restore.Status.Job.ID) == 0 {
+		if job, err = r.cloudCasa.FindLatestJob(ctx, cloudcasa.JobFilter{RestoreID: restore.Status.RestoreID}); err != nil || job == nil {
+			if restore.Status.Job == nil {
+				restore.Status.Job = &cloudcasav1alpha1.JobStatus{State: string(oapi.JobStatePENDING)}
+			}
+
+			return reconcile.Result{RequeueAfter: jobPollInterval}, err
+		}
+	} else if job, err = r.cloudCasa.GetJob(ctx, restore.Status.Job.ID); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	restore.Status.Job = jobStatus(job)
+
+	if !isJobFinished(restore.Status.Job.State) {
+		return reconcile.Result{RequeueAfter: jobPollInterval}, nil
+	}
+
+	status, message := metav1.ConditionTrue, "CloudCasa restore has been completed"
+	if !isJobSucceeded(restore.Status.Job.State) {
+		status, message = metav1.ConditionFalse, fmt.Sprintf("CloudCasa restore has not been completed: %s", restore.Status.Job.Message)
+	}
+
+	r.setCondition(restore, CompletedCondition, status, jobReason(restore.Status.Job.State), message)
+
+	return reconcile.Result{}, nil
+}
+
+func (r *TenantRestore) setCondition(restore *cloudcasav1alpha1.TenantRestore, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	eventType := corev1.EventTypeNormal
+	if status != metav1.ConditionTrue {
+		eventType = corev1.EventTypeWarning
+	}
+
+	r.recorder.Event(restore, eventType, reason, message)
+
+	meta.SetStatusCondition(&restore.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: restore.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// backupNamespaces returns the Namespaces selected by the backup instance.
+func backupNamespaces(instance *oapi.Backupinstance) []string {
+	if instance.BackupSelection == nil || instance.BackupSelection.Namespaces == nil {
+		return nil
+	}
+
+	return *instance.BackupSelection.Namespaces
+}
@@ -0,0 +1,231 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// testBackup is a Kubebackup run by the tests, producing a backup instance.
+type testBackup struct {
+	// cluster is the CloudCasa cluster ID of the Kubebackup, the test one if empty.
+	cluster       string
+	tenant        string
+	namespaces    []string
+	allNamespaces bool
+}
+
+// backupInstance runs the Kubebackup, returning the ID of its backup instance.
+func backupInstance(ctx context.Context, t *testing.T, service *fake.Service, backup testBackup) string {
+	t.Helper()
+
+	cluster := backup.cluster
+	if len(cluster) == 0 {
+		cluster = testClusterID
+	}
+
+	kubeBackup := oapi.Kubebackup{Cluster: oapi.KubeclusterId(cluster), Name: "nightly"}
+	if len(backup.tenant) > 0 {
+		kubeBackup.Tags = &map[string]interface{}{tenantTag: backup.tenant}
+	}
+
+	kubeBackup.Source.Namespaces = &backup.namespaces
+	kubeBackup.Source.AllNamespaces = &backup.allNamespaces
+
+	created, err := service.EnsureBackup(ctx, kubeBackup)
+	if err != nil {
+		t.Fatalf("cannot create CloudCasa Kubebackup: %s", err.Error())
+	}
+
+	if err = service.RunBackup(ctx, string(*created.Id), "nightly-run", 7); err != nil {
+		t.Fatalf("cannot run CloudCasa Kubebackup: %s", err.Error())
+	}
+
+	job, err := service.FindLatestJob(ctx, cloudcasa.JobFilter{Name: "nightly-run"})
+	if err != nil || job == nil || job.BackupInst == nil {
+		t.Fatalf("cannot retrieve the CloudCasa Kubebackup job: %v", err)
+	}
+
+	return string(*job.BackupInst)
+}
+
+func TestTenantRestoreReconcile(t *testing.T) {
+	testCases := []struct {
+		name      string
+		namespace string
+		// backup is the Kubebackup producing the restored instance, a missing instance if nil.
+		backup     *testBackup
+		namespaces []string
+		mapping    map[string]string
+		// reason of the refusal, the restore being accepted if empty.
+		reason  string
+		targets []string
+	}{
+		{
+			name:    "backed up Namespaces",
+			backup:  &testBackup{tenant: "oil", namespaces: []string{"oil-production"}},
+			targets: []string{"oil-production"},
+		},
+		{
+			name:    "untagged backup of the Tenant Namespaces",
+			backup:  &testBackup{namespaces: []string{"oil-production", "oil-staging"}},
+			targets: []string{"oil-production", "oil-staging"},
+		},
+		{
+			name:    "mapped into a Tenant Namespace",
+			backup:  &testBackup{tenant: "oil", namespaces: []string{"oil-production"}},
+			mapping: map[string]string{"oil-production": "oil-staging"},
+			targets: []string{"oil-staging"},
+		},
+		{
+			name:      "Namespace without Tenant",
+			namespace: "default",
+			backup:    &testBackup{tenant: "oil", namespaces: []string{"oil-production"}},
+			reason:    "TenantNotFound",
+		},
+		{
+			name:   "missing backup instance",
+			reason: "BackupNotFound",
+		},
+		{
+			name:       "Namespaces not backed up",
+			backup:     &testBackup{tenant: "oil", namespaces: []string{"oil-production"}},
+			namespaces: []string{"oil-staging"},
+			reason:     "NamespacesNotBackedUp",
+		},
+		{
+			name:   "backup tagged for another Tenant",
+			backup: &testBackup{tenant: "gas", namespaces: []string{"oil-production"}},
+			reason: "BackupOutsideTenant",
+		},
+		{
+			name:   "backup of another Tenant Namespaces",
+			backup: &testBackup{tenant: "oil", namespaces: []string{"gas-production"}},
+			reason: "BackupOutsideTenant",
+		},
+		{
+			name:   "backup of another cluster",
+			backup: &testBackup{cluster: "62d1a0b3c5e2f1a4b7c8d9ff", tenant: "oil", namespaces: []string{"oil-production"}},
+			reason: "BackupOutsideTenant",
+		},
+		{
+			name:       "backup of the whole cluster",
+			backup:     &testBackup{namespaces: []string{"oil-production"}, allNamespaces: true},
+			namespaces: []string{"oil-production"},
+			reason:     "BackupOutsideTenant",
+		},
+		{
+			name:       "mapping of Namespaces not restored",
+			backup:     &testBackup{tenant: "oil", namespaces: []string{"oil-production", "oil-staging"}},
+			namespaces: []string{"oil-production"},
+			mapping:    map[string]string{"oil-staging": "oil-production"},
+			reason:     "InvalidNamespaceMapping",
+		},
+		{
+			name:    "mapped into another Tenant Namespace",
+			backup:  &testBackup{tenant: "oil", namespaces: []string{"oil-production"}},
+			mapping: map[string]string{"oil-production": "gas-production"},
+			reason:  "NamespacesOutsideTenant",
+		},
+		{
+			name:    "mapped into a missing Namespace",
+			backup:  &testBackup{tenant: "oil", namespaces: []string{"oil-production"}},
+			mapping: map[string]string{"oil-production": "oil-testing"},
+			reason:  "NamespacesNotFound",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			service := fake.New()
+
+			instanceID := "62d1a0b3c5e2f1a4b7c8d9e0"
+			if tc.backup != nil {
+				instanceID = backupInstance(ctx, t, service, *tc.backup)
+			}
+
+			namespace := tc.namespace
+			if len(namespace) == 0 {
+				namespace = "oil-production"
+			}
+
+			restore := &cloudcasav1alpha1.TenantRestore{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "rollback", UID: "9d8c7b6a-5e4f-4a3b-8c2d-1e0f9a8b7c6d"},
+				Spec: cloudcasav1alpha1.TenantRestoreSpec{
+					BackupInstanceID: instanceID,
+					Namespaces:       tc.namespaces,
+					NamespaceMapping: tc.mapping,
+				},
+			}
+
+			objects := append(tenantObjects(t, "oil", map[string]string{annotations.ClusterIDAnnotation: testClusterID}, "oil-production", "oil-staging"), tenantObjects(t, "gas", nil, "gas-production")...)
+			objects = append(objects, restore)
+
+			capsuleLabel, err := capsulev1beta2.GetTypeLabel(&capsulev1beta2.Tenant{})
+			if err != nil {
+				t.Fatalf("cannot retrieve the Capsule Tenant label: %s", err.Error())
+			}
+
+			r := &TenantRestore{
+				client:       newFakeClient(objects...),
+				recorder:     record.NewFakeRecorder(100),
+				capsuleLabel: capsuleLabel,
+				cloudCasa:    service,
+				extractor:    &annotations.Extractor{},
+			}
+
+			if _, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(restore)}); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			current := &cloudcasav1alpha1.TenantRestore{}
+			if err = r.client.Get(ctx, client.ObjectKeyFromObject(restore), current); err != nil {
+				t.Fatalf("cannot retrieve TenantRestore: %s", err.Error())
+			}
+
+			accepted := meta.FindStatusCondition(current.Status.Conditions, AcceptedCondition)
+
+			if len(tc.reason) > 0 {
+				if accepted == nil || accepted.Status != metav1.ConditionFalse || accepted.Reason != tc.reason {
+					t.Fatalf("expected the TenantRestore to be refused with reason %s, got %+v", tc.reason, accepted)
+				}
+
+				if job, _ := service.FindLatestJob(ctx, cloudcasa.JobFilter{Name: cloudCasaName(restore.GetNamespace(), restore.GetName(), string(restore.GetUID()))}); job != nil {
+					t.Errorf("expected no CloudCasa Kuberestore to be run, got job %s", *job.Id)
+				}
+
+				return
+			}
+
+			if accepted == nil || accepted.Status != metav1.ConditionTrue {
+				t.Fatalf("expected the TenantRestore to be accepted, got %+v", accepted)
+			}
+
+			if !reflect.DeepEqual(current.Status.Namespaces, tc.targets) {
+				t.Errorf("expected the restore into %v, got %v", tc.targets, current.Status.Namespaces)
+			}
+
+			if current.Status.Job == nil || current.Status.Job.State != string(oapi.JobStateRUNNING) {
+				t.Errorf("expected the running CloudCasa job to be mirrored, got %+v", current.Status.Job)
+			}
+		})
+	}
+}
@@ -5,12 +5,20 @@ package annotations
 
 import (
 	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Annotations interface {
 	OwnerEmail(tenant *capsulev1beta2.Tenant, owner capsulev1beta2.OwnerSpec) string
+	OwnerEmailOverride(tenant *capsulev1beta2.Tenant, owner capsulev1beta2.OwnerSpec) (string, bool)
 	UserGroupID(object client.Object) (string, bool)
 	ClusterID(object client.Object) (string, bool)
 	OrganizationID(tenant *capsulev1beta2.Tenant) string
+	RetainUserGroup(object client.Object) bool
+	SyncBackups(object client.Object) bool
+	BackupSchedule(object client.Object) (BackupSchedule, bool)
+	Conditions(object client.Object) []metav1.Condition
+	InvitationPolicy(object client.Object) InvitationPolicy
+	Profile(object client.Object) (string, bool)
 }
@@ -4,8 +4,39 @@
 package annotations
 
 const (
-	OrganizationAnnotation   = "cloudcasa.io/organizationid"
-	ClusterIDAnnotation      = "cloudcasa.io/clusterid"
-	UserGroupAnnotation      = "cloudcasa.io/usergroup"
-	UserEmailOverridePattern = "user.cloudcasa.io"
+	OrganizationAnnotation     = "cloudcasa.io/organizationid"
+	ClusterIDAnnotation        = "cloudcasa.io/clusterid"
+	UserGroupAnnotation        = "cloudcasa.io/usergroup"
+	RetainUserGroupAnnotation  = "cloudcasa.io/retain-usergroup"
+	StatusAnnotation           = "cloudcasa.io/status"
+	InvitationPolicyAnnotation = "cloudcasa.io/invitation-policy"
+	ProfileAnnotation          = "cloudcasa.io/profile"
+	SyncBackupsAnnotation      = "cloudcasa.io/sync-backups"
+	// DefaultBackupAnnotation opts the Tenant out of the default backups when set to false.
+	DefaultBackupAnnotation              = "cloudcasa.io/default-backup"
+	DefaultBackupScheduleAnnotation      = "cloudcasa.io/default-backup-schedule"
+	DefaultBackupRetentionDaysAnnotation = "cloudcasa.io/default-backup-retention-days"
+	DefaultBackupTimezoneAnnotation      = "cloudcasa.io/default-backup-timezone"
+	UserEmailOverridePattern             = "user.cloudcasa.io"
+)
+
+// BackupSchedule is the schedule of the default backups of a Tenant.
+type BackupSchedule struct {
+	// Cron expression in the five fields format, no default backups if empty.
+	Cron string
+	// Number of days the backups are retained for.
+	RetentionDays int
+	// Timezone the cron expression is evaluated in.
+	Timezone string
+}
+
+type InvitationPolicy string
+
+const (
+	// InvitationPolicyResendExpired sends again the expired invitations, the declined ones are reported.
+	InvitationPolicyResendExpired InvitationPolicy = "ResendExpired"
+	// InvitationPolicyResendAll sends again both expired and declined invitations.
+	InvitationPolicyResendAll InvitationPolicy = "ResendAll"
+	// InvitationPolicyNever never sends again an invitation, expired and declined ones are reported.
+	InvitationPolicyNever InvitationPolicy = "Never"
 )
@@ -4,14 +4,22 @@
 package annotations
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-type Extractor struct{}
+type Extractor struct {
+	// DefaultClusterID is the CloudCasa cluster ID used for the objects without the ClusterIDAnnotation, if any.
+	DefaultClusterID string
+	// DefaultBackupSchedule is the schedule of the default backups of the Tenants, if any.
+	DefaultBackupSchedule BackupSchedule
+}
 
 func (e Extractor) OrganizationID(tenant *capsulev1beta2.Tenant) string {
 	annotations := tenant.GetAnnotations()
@@ -29,42 +37,133 @@ func (e Extractor) OrganizationID(tenant *capsulev1beta2.Tenant) string {
 }
 
 func (e Extractor) ClusterID(object client.Object) (string, bool) {
+	if v, ok := object.GetAnnotations()[ClusterIDAnnotation]; ok {
+		return v, true
+	}
+
+	return e.DefaultClusterID, len(e.DefaultClusterID) > 0
+}
+
+func (e Extractor) UserGroupID(object client.Object) (string, bool) {
 	annotations := object.GetAnnotations()
 
 	if annotations == nil {
 		return "", false
 	}
 
-	v, ok := annotations[ClusterIDAnnotation]
+	v, ok := annotations[UserGroupAnnotation]
 
 	return v, ok
 }
 
-func (e Extractor) UserGroupID(object client.Object) (string, bool) {
+func (e Extractor) RetainUserGroup(object client.Object) bool {
+	annotations := object.GetAnnotations()
+
+	if annotations == nil {
+		return false
+	}
+
+	return annotations[RetainUserGroupAnnotation] == "true"
+}
+
+func (e Extractor) SyncBackups(object client.Object) bool {
+	annotations := object.GetAnnotations()
+
+	if annotations == nil {
+		return false
+	}
+
+	return annotations[SyncBackupsAnnotation] == "true"
+}
+
+// BackupSchedule returns the schedule of the Tenant default backups, overriding the default one with the
+// annotations: false if the Tenant opted out, or no schedule is available.
+func (e Extractor) BackupSchedule(object client.Object) (BackupSchedule, bool) {
+	schedule, annotations := e.DefaultBackupSchedule, object.GetAnnotations()
+
+	if annotations[DefaultBackupAnnotation] == "false" {
+		return BackupSchedule{}, false
+	}
+
+	if v, ok := annotations[DefaultBackupScheduleAnnotation]; ok {
+		schedule.Cron = v
+	}
+
+	if v, err := strconv.Atoi(annotations[DefaultBackupRetentionDaysAnnotation]); err == nil && v > 0 {
+		schedule.RetentionDays = v
+	}
+
+	if v, ok := annotations[DefaultBackupTimezoneAnnotation]; ok {
+		schedule.Timezone = v
+	}
+
+	return schedule, len(schedule.Cron) > 0
+}
+
+func (e Extractor) Conditions(object client.Object) []metav1.Condition {
+	annotations := object.GetAnnotations()
+
+	if annotations == nil {
+		return nil
+	}
+
+	v, ok := annotations[StatusAnnotation]
+	if !ok {
+		return nil
+	}
+
+	var conditions []metav1.Condition
+	// A malformed status is just discarded, since it will be recomputed by the next reconciliation
+	if err := json.Unmarshal([]byte(v), &conditions); err != nil {
+		return nil
+	}
+
+	return conditions
+}
+
+func (e Extractor) InvitationPolicy(object client.Object) InvitationPolicy {
+	annotations := object.GetAnnotations()
+
+	if annotations == nil {
+		return InvitationPolicyResendExpired
+	}
+
+	switch policy := InvitationPolicy(annotations[InvitationPolicyAnnotation]); policy {
+	case InvitationPolicyResendAll, InvitationPolicyNever:
+		return policy
+	default:
+		return InvitationPolicyResendExpired
+	}
+}
+
+func (e Extractor) Profile(object client.Object) (string, bool) {
 	annotations := object.GetAnnotations()
 
 	if annotations == nil {
 		return "", false
 	}
 
-	v, ok := annotations[UserGroupAnnotation]
+	v, ok := annotations[ProfileAnnotation]
 
 	return v, ok
 }
 
 func (e Extractor) OwnerEmail(tenant *capsulev1beta2.Tenant, owner capsulev1beta2.OwnerSpec) string {
-	email := owner.Name
+	if email, ok := e.OwnerEmailOverride(tenant, owner); ok {
+		return email
+	}
+
+	return owner.Name
+}
 
+func (e Extractor) OwnerEmailOverride(tenant *capsulev1beta2.Tenant, owner capsulev1beta2.OwnerSpec) (string, bool) {
 	annotations := tenant.GetAnnotations()
 	if annotations == nil {
-		return email
+		return "", false
 	}
 
 	overrideAnnotation := fmt.Sprintf("%s/%s.%s", UserEmailOverridePattern, strings.ToLower(owner.Kind.String()), strings.ToLower(owner.Name))
 	v, ok := annotations[overrideAnnotation]
-	if !ok {
-		return email
-	}
 
-	return v
+	return v, ok
 }
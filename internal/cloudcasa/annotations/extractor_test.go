@@ -0,0 +1,84 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package annotations
+
+import (
+	"testing"
+
+	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBackupSchedule(t *testing.T) {
+	defaultSchedule := BackupSchedule{Cron: "0 2 * * *", RetentionDays: 7, Timezone: "UTC"}
+
+	testCases := []struct {
+		name        string
+		defaults    BackupSchedule
+		annotations map[string]string
+		expected    BackupSchedule
+		ok          bool
+	}{
+		{name: "no default schedule", ok: false},
+		{name: "default schedule", defaults: defaultSchedule, expected: defaultSchedule, ok: true},
+		{
+			name:        "opted out",
+			defaults:    defaultSchedule,
+			annotations: map[string]string{DefaultBackupAnnotation: "false"},
+			ok:          false,
+		},
+		{
+			name:     "overridden schedule",
+			defaults: defaultSchedule,
+			annotations: map[string]string{
+				DefaultBackupScheduleAnnotation:      "30 4 * * 0",
+				DefaultBackupRetentionDaysAnnotation: "30",
+				DefaultBackupTimezoneAnnotation:      "Europe/Rome",
+			},
+			expected: BackupSchedule{Cron: "30 4 * * 0", RetentionDays: 30, Timezone: "Europe/Rome"},
+			ok:       true,
+		},
+		{
+			name:        "schedule without a default one",
+			annotations: map[string]string{DefaultBackupScheduleAnnotation: "0 3 * * *"},
+			expected:    BackupSchedule{Cron: "0 3 * * *"},
+			ok:          true,
+		},
+		{
+			name:        "invalid retention days are ignored",
+			defaults:    defaultSchedule,
+			annotations: map[string]string{DefaultBackupRetentionDaysAnnotation: "-1"},
+			expected:    defaultSchedule,
+			ok:          true,
+		},
+		{
+			name:        "malformed retention days are ignored",
+			defaults:    defaultSchedule,
+			annotations: map[string]string{DefaultBackupRetentionDaysAnnotation: "week"},
+			expected:    defaultSchedule,
+			ok:          true,
+		},
+		{
+			name:        "empty schedule disables the default backups",
+			defaults:    defaultSchedule,
+			annotations: map[string]string{DefaultBackupScheduleAnnotation: ""},
+			ok:          false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant := &capsulev1beta2.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "oil", Annotations: tc.annotations}}
+
+			schedule, ok := Extractor{DefaultBackupSchedule: tc.defaults}.BackupSchedule(tenant)
+			if ok != tc.ok {
+				t.Fatalf("expected %t, got %t", tc.ok, ok)
+			}
+
+			if ok && schedule != tc.expected {
+				t.Errorf("expected schedule %+v, got %+v", tc.expected, schedule)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// SecretKey is the Secret key containing the CloudCasa API bearer token.
+const SecretKey = "token"
+
+// SecretWatcher reloads the bearer token whenever the referenced Secret changes,
+// allowing the rotation of the CloudCasa API key without restarting the addon.
+type SecretWatcher struct {
+	Token          *Token
+	Clientset      kubernetes.Interface
+	NamespacedName types.NamespacedName
+}
+
+// Load retrieves the bearer token from the Secret, meant to be used at startup before the watch is established.
+func (w *SecretWatcher) Load(ctx context.Context, reader client.Reader) error {
+	secret := &corev1.Secret{}
+
+	if err := reader.Get(ctx, w.NamespacedName, secret); err != nil {
+		return err
+	}
+
+	return w.load(secret)
+}
+
+func (w *SecretWatcher) load(secret *corev1.Secret) error {
+	value, ok := secret.Data[SecretKey]
+	if !ok || len(value) == 0 {
+		return fmt.Errorf("missing key %s in Secret %s", SecretKey, w.NamespacedName.String())
+	}
+
+	w.Token.Set(string(value))
+
+	return nil
+}
+
+func (w *SecretWatcher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("cloudcasa-token").WithValues("secret", w.NamespacedName.String())
+
+	lw := cache.NewFilteredListWatchFromClient(w.Clientset.CoreV1().RESTClient(), "secrets", w.NamespacedName.Namespace, func(options *metav1.ListOptions) {
+		options.FieldSelector = fields.OneTermEqualSelector("metadata.name", w.NamespacedName.Name).String()
+	})
+
+	informer := cache.NewSharedIndexInformer(lw, &corev1.Secret{}, 0, cache.Indexers{})
+
+	reload := func(obj interface{}) {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			return
+		}
+
+		if err := w.load(secret); err != nil {
+			logger.Error(err, "cannot reload CloudCasa API token")
+
+			return
+		}
+
+		logger.V(1).Info("CloudCasa API token has been reloaded")
+	}
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: reload,
+		UpdateFunc: func(_, newObj interface{}) {
+			reload(newObj)
+		},
+		DeleteFunc: func(interface{}) {
+			logger.Info("CloudCasa API token Secret has been deleted, keeping the last loaded token")
+		},
+	})
+
+	informer.Run(ctx.Done())
+
+	return nil
+}
+
+// NeedLeaderElection returns false since every replica must use the current bearer token.
+func (w *SecretWatcher) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	cloudcasa "github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// Token holds the bearer token used to interact with the CloudCasa API, which can be replaced at runtime,
+// tracking if the API server rejected it.
+type Token struct {
+	mutex        sync.RWMutex
+	value        string
+	unauthorized bool
+}
+
+func NewToken(value string) *Token {
+	return &Token{value: value}
+}
+
+// Set replaces the bearer token, resetting the authentication failure, if any.
+func (t *Token) Set(value string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.value != value {
+		t.value, t.unauthorized = value, false
+	}
+}
+
+func (t *Token) get() string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.value
+}
+
+func (t *Token) setUnauthorized(unauthorized bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.unauthorized = unauthorized
+}
+
+// RequestEditor sets the current bearer token as the request Authorization header.
+func (t *Token) RequestEditor(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.get()))
+
+	return nil
+}
+
+// Doer wraps the given HTTP client, tracking the authentication failures returned by the CloudCasa API server.
+func (t *Token) Doer(doer cloudcasa.HttpRequestDoer) cloudcasa.HttpRequestDoer {
+	return &trackingDoer{token: t, doer: doer}
+}
+
+// Check is a readiness check failing when the bearer token has been rejected by the CloudCasa API server.
+func (t *Token) Check(*http.Request) error {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	switch {
+	case len(t.value) == 0:
+		return fmt.Errorf("CloudCasa API token has not been loaded yet")
+	case t.unauthorized:
+		return fmt.Errorf("CloudCasa API token has been rejected, authentication failed")
+	default:
+		return nil
+	}
+}
+
+type trackingDoer struct {
+	token *Token
+	doer  cloudcasa.HttpRequestDoer
+}
+
+func (d *trackingDoer) Do(req *http.Request) (*http.Response, error) {
+	res, err := d.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode == http.StatusUnauthorized:
+		d.token.setUnauthorized(true)
+	case res.StatusCode < http.StatusBadRequest:
+		d.token.setUnauthorized(false)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,306 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudcasa
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/metrics"
+)
+
+type cachedUserGroup struct {
+	etag      string
+	userGroup *oapi.Usergroup
+	expiresAt time.Time
+}
+
+type cachedID struct {
+	id        string
+	expiresAt time.Time
+}
+
+type cachedNamespace struct {
+	namespace *oapi.Kubenamespace
+	expiresAt time.Time
+}
+
+type cachedOrgs struct {
+	orgs      []oapi.Org
+	expiresAt time.Time
+}
+
+// Cache decorates a Service, keeping the retrieved UserGroups along with their ETag, the Kubenamespaces, and the Organizations
+// for the given TTL: the entries are invalidated upon writes, including the ones rejected due to a stale ETag.
+// The missing Kubenamespaces are not cached, since these are waited for until discovered by CloudCasa.
+type Cache struct {
+	Service
+
+	ttl time.Duration
+	now func() time.Time
+
+	mutex        sync.Mutex
+	userGroups   map[string]cachedUserGroup
+	userGroupIDs map[string]cachedID
+	namespaces   map[string]cachedNamespace
+	orgs         *cachedOrgs
+}
+
+func NewCache(service Service, ttl time.Duration) *Cache {
+	return &Cache{
+		Service:      service,
+		ttl:          ttl,
+		now:          time.Now,
+		userGroups:   map[string]cachedUserGroup{},
+		userGroupIDs: map[string]cachedID{},
+		namespaces:   map[string]cachedNamespace{},
+	}
+}
+
+// deepCopy returns a copy of the generated model, preventing the callers from changing the cached entries.
+func deepCopy(in, out interface{}) {
+	value, err := json.Marshal(in)
+	if err != nil {
+		panic(err)
+	}
+
+	if err = json.Unmarshal(value, out); err != nil {
+		panic(err)
+	}
+}
+
+func observe(resource string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	metrics.CacheRequests.WithLabelValues(resource, result).Inc()
+}
+
+func (c *Cache) storeUserGroup(etag string, userGroup *oapi.Usergroup) {
+	if userGroup == nil || userGroup.Id == nil {
+		return
+	}
+
+	out := &oapi.Usergroup{}
+	deepCopy(userGroup, out)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	expiresAt := c.now().Add(c.ttl)
+
+	c.userGroups[*userGroup.Id] = cachedUserGroup{etag: etag, userGroup: out, expiresAt: expiresAt}
+	c.userGroupIDs[userGroup.Name] = cachedID{id: *userGroup.Id, expiresAt: expiresAt}
+}
+
+func (c *Cache) invalidateUserGroup(id string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.userGroups, id)
+}
+
+func (c *Cache) GetUserGroup(ctx context.Context, id string) (string, *oapi.Usergroup, error) {
+	c.mutex.Lock()
+	entry, ok := c.userGroups[id]
+	c.mutex.Unlock()
+
+	if ok && c.now().Before(entry.expiresAt) {
+		observe("usergroup", true)
+
+		out := &oapi.Usergroup{}
+		deepCopy(entry.userGroup, out)
+
+		return entry.etag, out, nil
+	}
+
+	observe("usergroup", false)
+
+	etag, userGroup, err := c.Service.GetUserGroup(ctx, id)
+	if err != nil {
+		c.invalidateUserGroup(id)
+
+		return "", nil, err
+	}
+
+	c.storeUserGroup(etag, userGroup)
+
+	return etag, userGroup, nil
+}
+
+func (c *Cache) FindUserGroup(ctx context.Context, name string) (string, *oapi.Usergroup, error) {
+	c.mutex.Lock()
+	entry, ok := c.userGroupIDs[name]
+	c.mutex.Unlock()
+
+	if ok && c.now().Before(entry.expiresAt) {
+		etag, userGroup, err := c.GetUserGroup(ctx, entry.id)
+		if err == nil && userGroup.Name == name {
+			return etag, userGroup, nil
+		}
+
+		if err != nil && !IsNotFound(err) {
+			return "", nil, err
+		}
+		// The UserGroup has been deleted, or renamed
+		c.mutex.Lock()
+		delete(c.userGroupIDs, name)
+		c.mutex.Unlock()
+	}
+
+	etag, userGroup, err := c.Service.FindUserGroup(ctx, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	c.storeUserGroup(etag, userGroup)
+
+	return etag, userGroup, nil
+}
+
+func (c *Cache) EnsureUserGroup(ctx context.Context, name string, acls []oapi.UserGroupACL) (string, *oapi.Usergroup, error) {
+	etag, userGroup, err := c.FindUserGroup(ctx, name)
+	if err != nil || userGroup != nil {
+		return etag, userGroup, err
+	}
+
+	etag, userGroup, err = c.Service.EnsureUserGroup(ctx, name, acls)
+	if err != nil {
+		return "", nil, err
+	}
+
+	c.storeUserGroup(etag, userGroup)
+
+	return etag, userGroup, nil
+}
+
+func (c *Cache) UpdateUserGroupACL(ctx context.Context, id, etag string, acls []oapi.ACL) error {
+	defer c.invalidateUserGroup(id)
+
+	return c.Service.UpdateUserGroupACL(ctx, id, etag, acls)
+}
+
+func (c *Cache) PatchUserGroup(ctx context.Context, id, etag string, userGroup oapi.Usergroup) error {
+	defer c.invalidateUserGroup(id)
+
+	return c.Service.PatchUserGroup(ctx, id, etag, userGroup)
+}
+
+func (c *Cache) DeleteUserGroup(ctx context.Context, id, etag string) error {
+	defer c.invalidateUserGroup(id)
+
+	return c.Service.DeleteUserGroup(ctx, id, etag)
+}
+
+func (c *Cache) FindNamespace(ctx context.Context, clusterID, name string) (*oapi.Kubenamespace, error) {
+	namespaces, err := c.ResolveNamespaces(ctx, clusterID, []string{name})
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, ok := namespaces[name]
+	if !ok {
+		return nil, nil
+	}
+
+	return &namespace, nil
+}
+
+// ResolveNamespaces serves the cached Kubenamespaces, resolving the remaining ones with a single call.
+func (c *Cache) ResolveNamespaces(ctx context.Context, clusterID string, names []string) (map[string]oapi.Kubenamespace, error) {
+	namespaces, missing := make(map[string]oapi.Kubenamespace, len(names)), make([]string, 0, len(names))
+
+	c.mutex.Lock()
+	for _, name := range names {
+		entry, ok := c.namespaces[namespaceKey(clusterID, name)]
+		if ok && c.now().Before(entry.expiresAt) {
+			var namespace oapi.Kubenamespace
+			deepCopy(entry.namespace, &namespace)
+
+			namespaces[name] = namespace
+
+			continue
+		}
+
+		missing = append(missing, name)
+	}
+	c.mutex.Unlock()
+
+	metrics.CacheRequests.WithLabelValues("kubenamespace", "hit").Add(float64(len(namespaces)))
+	metrics.CacheRequests.WithLabelValues("kubenamespace", "miss").Add(float64(len(missing)))
+
+	if len(missing) == 0 {
+		return namespaces, nil
+	}
+
+	resolved, err := c.Service.ResolveNamespaces(ctx, clusterID, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	expiresAt := c.now().Add(c.ttl)
+
+	for name, namespace := range resolved {
+		cached := &oapi.Kubenamespace{}
+		deepCopy(namespace, cached)
+
+		namespaces[name] = namespace
+		c.namespaces[namespaceKey(clusterID, name)] = cachedNamespace{namespace: cached, expiresAt: expiresAt}
+	}
+
+	return namespaces, nil
+}
+
+func namespaceKey(clusterID, name string) string {
+	return clusterID + "/" + name
+}
+
+func (c *Cache) CreateNamespace(ctx context.Context, namespace oapi.Kubenamespace) error {
+	defer func() {
+		c.mutex.Lock()
+		delete(c.namespaces, namespaceKey(string(namespace.ClusterId), namespace.Name))
+		c.mutex.Unlock()
+	}()
+
+	return c.Service.CreateNamespace(ctx, namespace)
+}
+
+func (c *Cache) ListOrgs(ctx context.Context) ([]oapi.Org, error) {
+	c.mutex.Lock()
+	entry := c.orgs
+	c.mutex.Unlock()
+
+	if entry != nil && c.now().Before(entry.expiresAt) {
+		observe("org", true)
+
+		var out []oapi.Org
+		deepCopy(entry.orgs, &out)
+
+		return out, nil
+	}
+
+	observe("org", false)
+
+	orgs, err := c.Service.ListOrgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cached []oapi.Org
+	deepCopy(orgs, &cached)
+
+	c.mutex.Lock()
+	c.orgs = &cachedOrgs{orgs: cached, expiresAt: c.now().Add(c.ttl)}
+	c.mutex.Unlock()
+
+	return orgs, nil
+}
@@ -0,0 +1,287 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudcasa_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+const testTTL = time.Minute
+
+// countingService counts the cached calls reaching the fake Service.
+type countingService struct {
+	*fake.Service
+
+	calls map[string]int
+}
+
+func (s *countingService) GetUserGroup(ctx context.Context, id string) (string, *oapi.Usergroup, error) {
+	s.calls["GetUserGroup"]++
+
+	return s.Service.GetUserGroup(ctx, id)
+}
+
+func (s *countingService) FindUserGroup(ctx context.Context, name string) (string, *oapi.Usergroup, error) {
+	s.calls["FindUserGroup"]++
+
+	return s.Service.FindUserGroup(ctx, name)
+}
+
+func (s *countingService) ResolveNamespaces(ctx context.Context, clusterID string, names []string) (map[string]oapi.Kubenamespace, error) {
+	s.calls["ResolveNamespaces"] += len(names)
+
+	return s.Service.ResolveNamespaces(ctx, clusterID, names)
+}
+
+func (s *countingService) ListOrgs(ctx context.Context) ([]oapi.Org, error) {
+	s.calls["ListOrgs"]++
+
+	return s.Service.ListOrgs(ctx)
+}
+
+// clock is a manually advanced time source.
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+type cacheFixture struct {
+	service     *countingService
+	cache       *cloudcasa.Cache
+	clock       *clock
+	userGroupID string
+	clusterID   string
+}
+
+func TestCache(t *testing.T) {
+	testCases := []struct {
+		name     string
+		run      func(ctx context.Context, f cacheFixture) error
+		expected map[string]int
+	}{
+		{
+			name: "UserGroup is served from the cache",
+			run: func(ctx context.Context, f cacheFixture) error {
+				for i := 0; i < 3; i++ {
+					if _, _, err := f.cache.GetUserGroup(ctx, f.userGroupID); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+			expected: map[string]int{"GetUserGroup": 1},
+		},
+		{
+			name: "UserGroup is retrieved again once expired",
+			run: func(ctx context.Context, f cacheFixture) error {
+				if _, _, err := f.cache.GetUserGroup(ctx, f.userGroupID); err != nil {
+					return err
+				}
+
+				f.clock.Advance(testTTL)
+
+				_, _, err := f.cache.GetUserGroup(ctx, f.userGroupID)
+
+				return err
+			},
+			expected: map[string]int{"GetUserGroup": 2},
+		},
+		{
+			name: "UserGroup is invalidated upon changes",
+			run: func(ctx context.Context, f cacheFixture) error {
+				etag, _, err := f.cache.GetUserGroup(ctx, f.userGroupID)
+				if err != nil {
+					return err
+				}
+
+				if err = f.cache.PatchUserGroup(ctx, f.userGroupID, etag, oapi.Usergroup{Name: "gas"}); err != nil {
+					return err
+				}
+
+				_, _, err = f.cache.GetUserGroup(ctx, f.userGroupID)
+
+				return err
+			},
+			expected: map[string]int{"GetUserGroup": 2},
+		},
+		{
+			name: "UserGroup is invalidated upon stale ETags",
+			run: func(ctx context.Context, f cacheFixture) error {
+				if _, _, err := f.cache.GetUserGroup(ctx, f.userGroupID); err != nil {
+					return err
+				}
+
+				if err := f.cache.PatchUserGroup(ctx, f.userGroupID, "stale", oapi.Usergroup{Name: "gas"}); !cloudcasa.IsPreconditionFailed(err) {
+					return fmt.Errorf("expected a failed precondition, got %v", err)
+				}
+
+				_, _, err := f.cache.GetUserGroup(ctx, f.userGroupID)
+
+				return err
+			},
+			expected: map[string]int{"GetUserGroup": 2},
+		},
+		{
+			name: "UserGroup name is resolved once",
+			run: func(ctx context.Context, f cacheFixture) error {
+				for i := 0; i < 3; i++ {
+					if _, _, err := f.cache.FindUserGroup(ctx, "oil"); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+			expected: map[string]int{"FindUserGroup": 1},
+		},
+		{
+			name: "renamed UserGroup is looked up again",
+			run: func(ctx context.Context, f cacheFixture) error {
+				etag, _, err := f.cache.FindUserGroup(ctx, "oil")
+				if err != nil {
+					return err
+				}
+
+				if err = f.cache.PatchUserGroup(ctx, f.userGroupID, etag, oapi.Usergroup{Name: "gas"}); err != nil {
+					return err
+				}
+
+				_, _, err = f.cache.FindUserGroup(ctx, "oil")
+
+				return err
+			},
+			expected: map[string]int{"FindUserGroup": 2, "GetUserGroup": 1},
+		},
+		{
+			name: "Kubenamespaces are resolved once, the missing ones are not cached",
+			run: func(ctx context.Context, f cacheFixture) error {
+				for i := 0; i < 2; i++ {
+					if _, err := f.cache.ResolveNamespaces(ctx, f.clusterID, []string{"oil-production", "oil-development"}); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+			expected: map[string]int{"ResolveNamespaces": 3},
+		},
+		{
+			name: "Kubenamespace is invalidated upon registration",
+			run: func(ctx context.Context, f cacheFixture) error {
+				if _, err := f.cache.FindNamespace(ctx, f.clusterID, "oil-production"); err != nil {
+					return err
+				}
+
+				if err := f.cache.CreateNamespace(ctx, oapi.Kubenamespace{ClusterId: oapi.KubeclusterId(f.clusterID), Name: "oil-production"}); err != nil {
+					return err
+				}
+
+				_, err := f.cache.FindNamespace(ctx, f.clusterID, "oil-production")
+
+				return err
+			},
+			expected: map[string]int{"ResolveNamespaces": 2},
+		},
+		{
+			name: "Organizations are served from the cache until expired",
+			run: func(ctx context.Context, f cacheFixture) error {
+				for i := 0; i < 2; i++ {
+					if _, err := f.cache.ListOrgs(ctx); err != nil {
+						return err
+					}
+				}
+
+				f.clock.Advance(testTTL)
+
+				_, err := f.cache.ListOrgs(ctx)
+
+				return err
+			},
+			expected: map[string]int{"ListOrgs": 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			service := &countingService{Service: fake.New(), calls: map[string]int{}}
+			service.AddOrg("clastix")
+
+			clusterID := service.AddCluster("capsule")
+			service.AddNamespace(clusterID, "oil-production", "f2a1e4a0-8c6a-4cf1-9f0e-7d1c2c6e6d53")
+
+			_, userGroup, err := service.EnsureUserGroup(ctx, "oil", nil)
+			if err != nil {
+				t.Fatalf("cannot create UserGroup: %s", err.Error())
+			}
+
+			service.calls = map[string]int{}
+
+			f := cacheFixture{
+				service:     service,
+				cache:       cloudcasa.NewCache(service, testTTL),
+				clock:       &clock{now: time.Now()},
+				userGroupID: *userGroup.Id,
+				clusterID:   clusterID,
+			}
+			f.cache.SetNow(f.clock.Now)
+
+			if err = tc.run(ctx, f); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			for method, calls := range tc.expected {
+				if service.calls[method] != calls {
+					t.Errorf("expected %d calls to %s, got %d", calls, method, service.calls[method])
+				}
+			}
+
+			for method, calls := range service.calls {
+				if _, ok := tc.expected[method]; !ok && calls > 0 {
+					t.Errorf("unexpected %d calls to %s", calls, method)
+				}
+			}
+		})
+	}
+}
+
+func TestCacheReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+
+	service := fake.New()
+
+	_, created, err := service.EnsureUserGroup(ctx, "oil", nil)
+	if err != nil {
+		t.Fatalf("cannot create UserGroup: %s", err.Error())
+	}
+
+	cache := cloudcasa.NewCache(service, testTTL)
+
+	_, userGroup, err := cache.GetUserGroup(ctx, *created.Id)
+	if err != nil {
+		t.Fatalf("cannot retrieve UserGroup: %s", err.Error())
+	}
+
+	userGroup.Name = "gas"
+
+	if _, userGroup, err = cache.GetUserGroup(ctx, *created.Id); err != nil || userGroup.Name != "oil" {
+		t.Errorf("expected the cached UserGroup to be unchanged, got %+v (%v)", userGroup, err)
+	}
+}
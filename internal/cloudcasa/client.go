@@ -0,0 +1,712 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudcasa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	goerr "github.com/pkg/errors"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/auth"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// Client implements the Service on top of the generated oapi client.
+type Client struct {
+	oapi *oapi.ClientWithResponses
+}
+
+var _ Service = &Client{}
+
+// NewClient returns the CloudCasa Service for the given API server, performing the requests with the given HTTP client
+// and authenticating them with the current bearer token.
+func NewClient(serverURL string, token *auth.Token, doer oapi.HttpRequestDoer) (*Client, error) {
+	cc, err := oapi.NewClientWithResponses(serverURL, oapi.WithHTTPClient(token.Doer(doer)), oapi.WithRequestEditorFn(token.RequestEditor))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{oapi: cc}, nil
+}
+
+// resolveBatchSize is the maximum number of Kubenamespace names resolved with a single query.
+const resolveBatchSize = 100
+
+// where returns the Eve filter matching all the given fields.
+func where(fields map[string]interface{}) (*oapi.QueryWhere, error) {
+	value, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	q := oapi.QueryWhere(value)
+
+	return &q, nil
+}
+
+func (c *Client) GetUserGroup(ctx context.Context, id string) (string, *oapi.Usergroup, error) {
+	res, err := c.oapi.GetUsergroupItemWithResponse(ctx, oapi.UsergroupId(id))
+	if err != nil {
+		return "", nil, goerr.Wrap(err, "cannot create request for CloudCasa UserGroup retrieval")
+	}
+
+	if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil {
+		return "", nil, err
+	}
+
+	if res.JSON200 == nil {
+		return "", nil, fmt.Errorf("unhandled error for CloudCasa UserGroup retrieval")
+	}
+
+	return res.HTTPResponse.Header.Get("etag"), res.JSON200, nil
+}
+
+func (c *Client) FindUserGroup(ctx context.Context, name string) (string, *oapi.Usergroup, error) {
+	w, err := where(map[string]interface{}{"name": name})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var items []oapi.Usergroup
+
+	err = listPages("UserGroups", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1usergroups(ctx, &oapi.Getv1usergroupsParams{Where: w, Page: &page, MaxResults: &maxResults})
+	}, collect(&items))
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch {
+	case len(items) > 1:
+		return "", nil, fmt.Errorf("multiple UserGroups with the name %s", name)
+	case len(items) == 0:
+		return "", nil, nil
+	default:
+		return c.GetUserGroup(ctx, *items[0].Id)
+	}
+}
+
+func (c *Client) EnsureUserGroup(ctx context.Context, name string, acls []oapi.UserGroupACL) (string, *oapi.Usergroup, error) {
+	etag, userGroup, err := c.FindUserGroup(ctx, name)
+	if err != nil || userGroup != nil {
+		return etag, userGroup, err
+	}
+
+	res, err := c.oapi.Postv1usergroupsWithResponse(ctx, oapi.Postv1usergroupsJSONRequestBody{
+		Acls: &acls,
+		Name: name,
+	})
+	if err != nil {
+		return "", nil, goerr.Wrap(err, "cannot create CloudCasa UserGroup")
+	}
+
+	if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil {
+		return "", nil, err
+	}
+
+	return c.FindUserGroup(ctx, name)
+}
+
+func (c *Client) UpdateUserGroupACL(ctx context.Context, id, etag string, acls []oapi.ACL) error {
+	res, err := c.oapi.UpdateUserGroupACLWithResponse(ctx, oapi.UsergroupId(id), &oapi.UpdateUserGroupACLParams{IfMatch: oapi.IfMatch(etag)}, oapi.UpdateUserGroupACLJSONRequestBody{
+		Acls: &acls,
+	})
+	if err != nil {
+		return goerr.Wrap(err, "cannot update CloudCasa UserGroup ACL")
+	}
+
+	return newAPIError(res.StatusCode(), res.JSONDefault)
+}
+
+func (c *Client) PatchUserGroup(ctx context.Context, id, etag string, userGroup oapi.Usergroup) error {
+	res, err := c.oapi.PatchUsergroupItemWithResponse(ctx, oapi.UsergroupId(id), &oapi.PatchUsergroupItemParams{IfMatch: oapi.IfMatch(etag)}, oapi.PatchUsergroupItemJSONRequestBody(userGroup))
+	if err != nil {
+		return goerr.Wrap(err, "cannot update CloudCasa UserGroup")
+	}
+
+	return newAPIError(res.StatusCode(), res.JSONDefault)
+}
+
+func (c *Client) DeleteUserGroup(ctx context.Context, id, etag string) error {
+	res, err := c.oapi.DeleteUsergroupItemWithResponse(ctx, oapi.UsergroupId(id), &oapi.DeleteUsergroupItemParams{IfMatch: oapi.IfMatch(etag)})
+	if err != nil {
+		return goerr.Wrap(err, "cannot delete CloudCasa UserGroup")
+	}
+
+	if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) ListInvites(ctx context.Context, filter InviteFilter) ([]oapi.Orginvite, error) {
+	fields := map[string]interface{}{}
+
+	if len(filter.Email) > 0 {
+		fields["email"] = filter.Email
+	}
+
+	if len(filter.State) > 0 {
+		fields["state"] = filter.State
+	}
+
+	for k, v := range filter.Tags {
+		fields[fmt.Sprintf("tags.%s", k)] = v
+	}
+
+	w, err := where(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []oapi.Orginvite
+
+	err = listPages("OrgInvites", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1orginvites(ctx, &oapi.Getv1orginvitesParams{Where: w, Page: &page, MaxResults: &maxResults})
+	}, collect(&items))
+
+	return items, err
+}
+
+func (c *Client) CreateInvite(ctx context.Context, invite oapi.Orginvite) error {
+	res, err := c.oapi.Postv1orginvitesWithResponse(ctx, oapi.Postv1orginvitesJSONRequestBody(invite))
+	if err != nil {
+		return goerr.Wrap(err, "cannot create CloudCasa OrgInvite")
+	}
+
+	return newAPIError(res.StatusCode(), res.JSONDefault)
+}
+
+func (c *Client) DeleteInvite(ctx context.Context, id string) error {
+	item, err := c.oapi.GetOrginviteItemWithResponse(ctx, oapi.OrginviteId(id))
+	if err != nil {
+		return goerr.Wrap(err, "cannot create request for CloudCasa OrgInvite retrieval")
+	}
+
+	switch err = newAPIError(item.StatusCode(), item.JSONDefault); {
+	case IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	res, err := c.oapi.DeleteOrginviteItemWithResponse(ctx, oapi.OrginviteId(id), &oapi.DeleteOrginviteItemParams{IfMatch: oapi.IfMatch(item.HTTPResponse.Header.Get("etag"))})
+	if err != nil {
+		return goerr.Wrap(err, "cannot delete CloudCasa OrgInvite")
+	}
+
+	if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) ListUsers(ctx context.Context, filter UserFilter) ([]oapi.User, error) {
+	fields := map[string]interface{}{}
+
+	if len(filter.Email) > 0 {
+		fields["email"] = filter.Email
+	}
+
+	if filter.IDs != nil {
+		fields["_id"] = map[string]interface{}{"$in": filter.IDs}
+	}
+
+	w, err := where(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []oapi.User
+
+	err = listPages("users", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1users(ctx, &oapi.Getv1usersParams{Where: w, Page: &page, MaxResults: &maxResults})
+	}, collect(&items))
+
+	return items, err
+}
+
+func (c *Client) FindNamespace(ctx context.Context, clusterID, name string) (*oapi.Kubenamespace, error) {
+	w, err := where(map[string]interface{}{"cluster_id": clusterID, "name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.listNamespaces(ctx, w)
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return &items[0], nil
+}
+
+func (c *Client) FindNamespaceByUID(ctx context.Context, name, uid string) (*oapi.Kubenamespace, error) {
+	w, err := where(map[string]interface{}{"name": name, "k8s_uid": uid})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.listNamespaces(ctx, w)
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return &items[0], nil
+}
+
+func (c *Client) ResolveNamespaces(ctx context.Context, clusterID string, names []string) (map[string]oapi.Kubenamespace, error) {
+	namespaces := make(map[string]oapi.Kubenamespace, len(names))
+	// The filter is sent in the query string, splitting the names to keep the URL length bounded
+	for start := 0; start < len(names); start += resolveBatchSize {
+		end := start + resolveBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+
+		w, err := where(map[string]interface{}{
+			"cluster_id": clusterID,
+			"name":       map[string]interface{}{"$in": names[start:end]},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := c.listNamespaces(ctx, w)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			namespaces[item.Name] = item
+		}
+	}
+
+	return namespaces, nil
+}
+
+func (c *Client) listNamespaces(ctx context.Context, w *oapi.QueryWhere) ([]oapi.Kubenamespace, error) {
+	var items []oapi.Kubenamespace
+
+	err := listPages("Kubenamespaces", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1kubenamespaces(ctx, &oapi.Getv1kubenamespacesParams{Where: w, Page: &page, MaxResults: &maxResults})
+	}, collect(&items))
+
+	return items, err
+}
+
+func (c *Client) CreateNamespace(ctx context.Context, namespace oapi.Kubenamespace) error {
+	res, err := c.oapi.Postv1kubenamespacesWithResponse(ctx, oapi.Postv1kubenamespacesJSONRequestBody(namespace))
+	if err != nil {
+		return goerr.Wrap(err, "cannot create request for Kubernetes Namespace registration on CloudCasa")
+	}
+
+	return newAPIError(res.StatusCode(), res.JSONDefault)
+}
+
+func (c *Client) FindCluster(ctx context.Context, name string) (*oapi.Kubecluster, error) {
+	w, err := where(map[string]interface{}{"name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []oapi.Kubecluster
+
+	err = listPages("Kubeclusters", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1kubeclusters(ctx, &oapi.Getv1kubeclustersParams{Where: w, Page: &page, MaxResults: &maxResults})
+	}, collect(&items))
+
+	switch {
+	case err != nil || len(items) == 0:
+		return nil, err
+	case len(items) > 1:
+		return nil, fmt.Errorf("multiple Kubeclusters with the name %s", name)
+	default:
+		return &items[0], nil
+	}
+}
+
+func (c *Client) GetBackup(ctx context.Context, id string) (*oapi.Kubebackup, error) {
+	res, err := c.oapi.GetKubebackupItemWithResponse(ctx, oapi.KubebackupId(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "cannot create request for CloudCasa Kubebackup retrieval")
+	}
+
+	if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil {
+		return nil, err
+	}
+
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("unhandled error for CloudCasa Kubebackup retrieval")
+	}
+
+	return res.JSON200, nil
+}
+
+func (c *Client) ListBackups(ctx context.Context, filter BackupFilter) ([]oapi.Kubebackup, error) {
+	fields := map[string]interface{}{}
+
+	if len(filter.Name) > 0 {
+		fields["name"] = filter.Name
+	}
+
+	if len(filter.ClusterID) > 0 {
+		fields["cluster"] = filter.ClusterID
+	}
+
+	for k, v := range filter.Tags {
+		fields[fmt.Sprintf("tags.%s", k)] = v
+	}
+
+	w, err := where(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []oapi.Kubebackup
+
+	err = listPages("Kubebackups", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1kubebackups(ctx, &oapi.Getv1kubebackupsParams{Where: w, Page: &page, MaxResults: &maxResults})
+	}, collect(&items))
+
+	return items, err
+}
+
+func (c *Client) FindBackup(ctx context.Context, name string) (*oapi.Kubebackup, error) {
+	items, err := c.ListBackups(ctx, BackupFilter{Name: name})
+
+	switch {
+	case err != nil || len(items) == 0:
+		return nil, err
+	case len(items) > 1:
+		return nil, fmt.Errorf("multiple Kubebackups with the name %s", name)
+	default:
+		return &items[0], nil
+	}
+}
+
+func (c *Client) EnsureBackup(ctx context.Context, backup oapi.Kubebackup) (*oapi.Kubebackup, error) {
+	item, err := c.FindBackup(ctx, backup.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		res, err := c.oapi.Postv1kubebackupsWithResponse(ctx, oapi.Postv1kubebackupsJSONRequestBody(backup))
+		if err != nil {
+			return nil, goerr.Wrap(err, "cannot create CloudCasa Kubebackup")
+		}
+
+		if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil {
+			return nil, err
+		}
+
+		if item, err = c.FindBackup(ctx, backup.Name); err != nil {
+			return nil, err
+		}
+	}
+	// The creation doesn't return the resource, which is looked up by name
+	if item == nil || item.Id == nil {
+		return nil, fmt.Errorf("cannot retrieve the ID of CloudCasa Kubebackup %s", backup.Name)
+	}
+
+	return item, nil
+}
+
+func (c *Client) RunBackup(ctx context.Context, id, name string, retentionDays int) error {
+	run := oapi.Runkubebackup{Backup: oapi.KubebackupId(id), Name: name}
+	run.Retention.RetainDays = retentionDays
+
+	res, err := c.oapi.Postv1runkubebackupWithResponse(ctx, oapi.Postv1runkubebackupJSONRequestBody(run))
+	if err != nil {
+		return goerr.Wrap(err, "cannot run CloudCasa Kubebackup")
+	}
+
+	return newAPIError(res.StatusCode(), res.JSONDefault)
+}
+
+func (c *Client) UpdateBackup(ctx context.Context, id string, backup oapi.Kubebackup) error {
+	item, err := c.oapi.GetKubebackupItemWithResponse(ctx, oapi.KubebackupId(id))
+	if err != nil {
+		return goerr.Wrap(err, "cannot create request for CloudCasa Kubebackup retrieval")
+	}
+
+	if err = newAPIError(item.StatusCode(), item.JSONDefault); err != nil {
+		return err
+	}
+
+	res, err := c.oapi.PatchKubebackupItemWithResponse(ctx, oapi.KubebackupId(id), &oapi.PatchKubebackupItemParams{IfMatch: oapi.IfMatch(item.HTTPResponse.Header.Get("etag"))}, oapi.PatchKubebackupItemJSONRequestBody(backup))
+	if err != nil {
+		return goerr.Wrap(err, "cannot update CloudCasa Kubebackup")
+	}
+
+	return newAPIError(res.StatusCode(), res.JSONDefault)
+}
+
+func (c *Client) DeleteBackup(ctx context.Context, id string) error {
+	item, err := c.oapi.GetKubebackupItemWithResponse(ctx, oapi.KubebackupId(id))
+	if err != nil {
+		return goerr.Wrap(err, "cannot create request for CloudCasa Kubebackup retrieval")
+	}
+
+	switch err = newAPIError(item.StatusCode(), item.JSONDefault); {
+	case IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	res, err := c.oapi.DeleteKubebackupItemWithResponse(ctx, oapi.KubebackupId(id), &oapi.DeleteKubebackupItemParams{IfMatch: oapi.IfMatch(item.HTTPResponse.Header.Get("etag"))})
+	if err != nil {
+		return goerr.Wrap(err, "cannot delete CloudCasa Kubebackup")
+	}
+
+	if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetBackupInstance(ctx context.Context, id string) (*oapi.Backupinstance, error) {
+	res, err := c.oapi.GetBackupinstanceItemWithResponse(ctx, oapi.BackupinstanceId(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "cannot create request for CloudCasa backup instance retrieval")
+	}
+
+	if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil {
+		return nil, err
+	}
+
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("unhandled error for CloudCasa backup instance retrieval")
+	}
+
+	return res.JSON200, nil
+}
+
+func (c *Client) EnsureRestore(ctx context.Context, restore oapi.Kuberestore) (*oapi.Kuberestore, error) {
+	item, err := c.findRestore(ctx, restore.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		res, err := c.oapi.Postv1kuberestoresWithResponse(ctx, oapi.Postv1kuberestoresJSONRequestBody(restore))
+		if err != nil {
+			return nil, goerr.Wrap(err, "cannot create CloudCasa Kuberestore")
+		}
+
+		if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil {
+			return nil, err
+		}
+
+		if item, err = c.findRestore(ctx, restore.Name); err != nil {
+			return nil, err
+		}
+	}
+	// The creation doesn't return the resource, which is looked up by name
+	if item == nil || item.Id == nil {
+		return nil, fmt.Errorf("cannot retrieve the ID of CloudCasa Kuberestore %s", restore.Name)
+	}
+
+	return item, nil
+}
+
+func (c *Client) findRestore(ctx context.Context, name string) (*oapi.Kuberestore, error) {
+	w, err := where(map[string]interface{}{"name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []oapi.Kuberestore
+
+	err = listPages("Kuberestores", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1kuberestores(ctx, &oapi.Getv1kuberestoresParams{Where: w, Page: &page, MaxResults: &maxResults})
+	}, collect(&items))
+
+	switch {
+	case err != nil || len(items) == 0:
+		return nil, err
+	case len(items) > 1:
+		return nil, fmt.Errorf("multiple Kuberestores with the name %s", name)
+	default:
+		return &items[0], nil
+	}
+}
+
+func (c *Client) EnsurePolicy(ctx context.Context, policy oapi.Policy) (*oapi.Policy, error) {
+	item, err := c.findPolicy(ctx, policy.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		res, err := c.oapi.Postv1policiesWithResponse(ctx, oapi.Postv1policiesJSONRequestBody(policy))
+		if err != nil {
+			return nil, goerr.Wrap(err, "cannot create CloudCasa Policy")
+		}
+
+		if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil {
+			return nil, err
+		}
+
+		if item, err = c.findPolicy(ctx, policy.Name); err != nil {
+			return nil, err
+		}
+	}
+	// The creation doesn't return the resource, which is looked up by name
+	if item == nil || item.Id == nil {
+		return nil, fmt.Errorf("cannot retrieve the ID of CloudCasa Policy %s", policy.Name)
+	}
+
+	return item, nil
+}
+
+func (c *Client) findPolicy(ctx context.Context, name string) (*oapi.Policy, error) {
+	w, err := where(map[string]interface{}{"name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []oapi.Policy
+
+	err = listPages("Policies", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1policies(ctx, &oapi.Getv1policiesParams{Where: w, Page: &page, MaxResults: &maxResults})
+	}, collect(&items))
+
+	switch {
+	case err != nil || len(items) == 0:
+		return nil, err
+	case len(items) > 1:
+		return nil, fmt.Errorf("multiple Policies with the name %s", name)
+	default:
+		return &items[0], nil
+	}
+}
+
+func (c *Client) ReplacePolicy(ctx context.Context, id string, policy oapi.Policy) error {
+	item, err := c.oapi.GetpolicyItemWithResponse(ctx, oapi.PolicyId(id))
+	if err != nil {
+		return goerr.Wrap(err, "cannot create request for CloudCasa Policy retrieval")
+	}
+
+	if err = newAPIError(item.StatusCode(), item.JSONDefault); err != nil {
+		return err
+	}
+
+	res, err := c.oapi.PutpolicyItemWithResponse(ctx, oapi.PolicyId(id), &oapi.PutpolicyItemParams{IfMatch: oapi.IfMatch(item.HTTPResponse.Header.Get("etag"))}, oapi.PutpolicyItemJSONRequestBody(policy))
+	if err != nil {
+		return goerr.Wrap(err, "cannot replace CloudCasa Policy")
+	}
+
+	return newAPIError(res.StatusCode(), res.JSONDefault)
+}
+
+func (c *Client) DeletePolicy(ctx context.Context, id string) error {
+	item, err := c.oapi.GetpolicyItemWithResponse(ctx, oapi.PolicyId(id))
+	if err != nil {
+		return goerr.Wrap(err, "cannot create request for CloudCasa Policy retrieval")
+	}
+
+	switch err = newAPIError(item.StatusCode(), item.JSONDefault); {
+	case IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	res, err := c.oapi.DeletepolicyItemWithResponse(ctx, oapi.PolicyId(id), &oapi.DeletepolicyItemParams{IfMatch: oapi.IfMatch(item.HTTPResponse.Header.Get("etag"))})
+	if err != nil {
+		return goerr.Wrap(err, "cannot delete CloudCasa Policy")
+	}
+
+	if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetJob(ctx context.Context, id string) (*oapi.Job, error) {
+	res, err := c.oapi.GetJobItemWithResponse(ctx, oapi.JobId(id))
+	if err != nil {
+		return nil, goerr.Wrap(err, "cannot create request for CloudCasa Job retrieval")
+	}
+
+	if err = newAPIError(res.StatusCode(), res.JSONDefault); err != nil {
+		return nil, err
+	}
+
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("unhandled error for CloudCasa Job retrieval")
+	}
+
+	return res.JSON200, nil
+}
+
+func (c *Client) FindLatestJob(ctx context.Context, filter JobFilter) (*oapi.Job, error) {
+	fields := map[string]interface{}{}
+
+	if len(filter.Name) > 0 {
+		fields["name"] = filter.Name
+	}
+
+	if len(filter.BackupID) > 0 {
+		fields["backupdef"] = filter.BackupID
+	}
+
+	if len(filter.RestoreID) > 0 {
+		fields["restoredef"] = filter.RestoreID
+	}
+
+	w, err := where(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *oapi.Job
+
+	err = listPages("Jobs", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1jobs(ctx, &oapi.Getv1jobsParams{Where: w, Page: &page, MaxResults: &maxResults})
+	}, func(data json.RawMessage) (int, error) {
+		var items []oapi.Job
+
+		if err := json.Unmarshal(data, &items); err != nil {
+			return 0, err
+		}
+
+		for i := range items {
+			if latest == nil || startTime(&items[i]) > startTime(latest) {
+				latest = &items[i]
+			}
+		}
+
+		return len(items), nil
+	})
+
+	return latest, err
+}
+
+func startTime(job *oapi.Job) int {
+	if job.StartTime == nil {
+		return 0
+	}
+
+	return *job.StartTime
+}
+
+func (c *Client) ListOrgs(ctx context.Context) ([]oapi.Org, error) {
+	var items []oapi.Org
+
+	err := listPages("Organizations", func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error) {
+		return c.oapi.Getv1orgs(ctx, &oapi.Getv1orgsParams{Page: &page, MaxResults: &maxResults})
+	}, collect(&items))
+
+	return items, err
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cluster resolves the CloudCasa ID of the cluster the addon is running in.
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+)
+
+// kubeSystemNamespace is the Namespace discovered by the CloudCasa agent in every cluster:
+// its UID identifies the cluster, regardless of the name it has been registered with.
+const kubeSystemNamespace = "kube-system"
+
+// Discovery looks up the CloudCasa cluster ID, in order, from the ConfigMap written by the CloudCasa agent,
+// from the Kubecluster with the given name, and from the Kubenamespace matching the kube-system Namespace UID.
+type Discovery struct {
+	Reader  client.Reader
+	Service cloudcasa.Service
+	// ConfigMap is the ConfigMap containing the cluster ID in the ConfigMapKey key, skipped if empty.
+	ConfigMap    types.NamespacedName
+	ConfigMapKey string
+	// ClusterName is the name of the Kubecluster, skipped if empty.
+	ClusterName string
+}
+
+// ClusterID returns the CloudCasa ID of the cluster, empty if it cannot be discovered.
+func (d Discovery) ClusterID(ctx context.Context) (string, error) {
+	logger := log.FromContext(ctx)
+
+	if len(d.ConfigMap.Name) > 0 {
+		id, err := d.fromConfigMap(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		if len(id) > 0 {
+			logger.Info(fmt.Sprintf("discovered CloudCasa cluster ID %s from ConfigMap %s", id, d.ConfigMap.String()))
+
+			return id, nil
+		}
+	}
+
+	if len(d.ClusterName) > 0 {
+		kubeCluster, err := d.Service.FindCluster(ctx, d.ClusterName)
+		if err != nil {
+			return "", fmt.Errorf("cannot retrieve CloudCasa Kubecluster %s: %w", d.ClusterName, err)
+		}
+
+		if kubeCluster != nil && kubeCluster.Id != nil {
+			logger.Info(fmt.Sprintf("discovered CloudCasa cluster ID %s from Kubecluster %s", *kubeCluster.Id, d.ClusterName))
+
+			return string(*kubeCluster.Id), nil
+		}
+	}
+
+	ns := &corev1.Namespace{}
+
+	if err := d.Reader.Get(ctx, types.NamespacedName{Name: kubeSystemNamespace}, ns); err != nil {
+		return "", fmt.Errorf("cannot retrieve Namespace %s: %w", kubeSystemNamespace, err)
+	}
+
+	kubeNamespace, err := d.Service.FindNamespaceByUID(ctx, kubeSystemNamespace, string(ns.GetUID()))
+	if err != nil {
+		return "", fmt.Errorf("cannot retrieve CloudCasa Kubenamespace %s: %w", kubeSystemNamespace, err)
+	}
+
+	if kubeNamespace == nil {
+		return "", nil
+	}
+
+	logger.Info(fmt.Sprintf("discovered CloudCasa cluster ID %s from Namespace %s UID", kubeNamespace.ClusterId, kubeSystemNamespace))
+
+	return string(kubeNamespace.ClusterId), nil
+}
+
+func (d Discovery) fromConfigMap(ctx context.Context) (string, error) {
+	cm := &corev1.ConfigMap{}
+
+	if err := d.Reader.Get(ctx, d.ConfigMap, cm); err != nil {
+		// The CloudCasa agent could be still not installed, falling back to the other sources
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("cannot retrieve cluster ID ConfigMap: %w", err)
+	}
+
+	return cm.Data[d.ConfigMapKey], nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+)
+
+const kubeSystemUID = "4b8e6f1e-3c2d-4a5b-9e7f-1a2b3c4d5e6f"
+
+func TestClusterID(t *testing.T) {
+	configMap := types.NamespacedName{Namespace: "cloudcasa-io", Name: "cloudcasa-cluster-id"}
+
+	testCases := []struct {
+		name        string
+		configMap   *corev1.ConfigMap
+		clusterName string
+		seed        func(service *fake.Service) string
+		expected    string
+	}{
+		{
+			name: "from the ConfigMap",
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: configMap.Namespace, Name: configMap.Name},
+				Data:       map[string]string{"cluster-id": "62d1a0b3c5e2f1a4b7c8d9e0"},
+			},
+			clusterName: "capsule",
+			seed: func(service *fake.Service) string {
+				service.AddCluster("capsule")
+
+				return "62d1a0b3c5e2f1a4b7c8d9e0"
+			},
+		},
+		{
+			name: "from the Kubecluster when the ConfigMap key is missing",
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: configMap.Namespace, Name: configMap.Name},
+			},
+			clusterName: "capsule",
+			seed: func(service *fake.Service) string {
+				return service.AddCluster("capsule")
+			},
+		},
+		{
+			name:        "from the Kubecluster when the ConfigMap is missing",
+			clusterName: "capsule",
+			seed: func(service *fake.Service) string {
+				return service.AddCluster("capsule")
+			},
+		},
+		{
+			name:        "from the kube-system Namespace UID when the Kubecluster is missing",
+			clusterName: "capsule",
+			seed: func(service *fake.Service) string {
+				clusterID := service.AddCluster("production")
+				service.AddNamespace(clusterID, kubeSystemNamespace, kubeSystemUID)
+
+				return clusterID
+			},
+		},
+		{
+			name: "from the kube-system Namespace UID among the other clusters",
+			seed: func(service *fake.Service) string {
+				service.AddNamespace(service.AddCluster("staging"), kubeSystemNamespace, "8f9e0d1c-2b3a-4c5d-6e7f-8a9b0c1d2e3f")
+
+				clusterID := service.AddCluster("production")
+				service.AddNamespace(clusterID, kubeSystemNamespace, kubeSystemUID)
+
+				return clusterID
+			},
+		},
+		{
+			name: "not discovered",
+			seed: func(service *fake.Service) string {
+				service.AddCluster("production")
+
+				return ""
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: kubeSystemNamespace, UID: kubeSystemUID}},
+			}
+			if tc.configMap != nil {
+				objects = append(objects, tc.configMap)
+			}
+
+			service := fake.New()
+			expected := tc.seed(service)
+
+			discovery := Discovery{
+				Reader:       fakeclient.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(objects...).Build(),
+				Service:      service,
+				ConfigMap:    configMap,
+				ConfigMapKey: "cluster-id",
+				ClusterName:  tc.clusterName,
+			}
+
+			id, err := discovery.ClusterID(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if id != expected {
+				t.Errorf("expected cluster ID %q, got %q", expected, id)
+			}
+		})
+	}
+}
+
+func TestClusterIDWithoutKubeSystem(t *testing.T) {
+	discovery := Discovery{
+		Reader:  fakeclient.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(),
+		Service: fake.New(),
+	}
+
+	if _, err := discovery.ClusterID(context.Background()); err == nil {
+		t.Error("expected an error when the kube-system Namespace cannot be retrieved")
+	}
+}
+
+func TestClusterIDAmbiguousName(t *testing.T) {
+	service := fake.New()
+	service.AddCluster("capsule")
+	service.AddCluster("capsule")
+
+	discovery := Discovery{
+		Reader:      fakeclient.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(),
+		Service:     service,
+		ClusterName: "capsule",
+	}
+
+	if _, err := discovery.ClusterID(context.Background()); err == nil {
+		t.Error("expected an error when multiple Kubeclusters share the name")
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudcasa
+
+import (
+	"fmt"
+	"net/http"
+
+	goerr "github.com/pkg/errors"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// APIError is the error returned by the CloudCasa API server.
+type APIError struct {
+	StatusCode int
+	Code       int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s (%d)", e.Message, e.Code)
+}
+
+// newAPIError returns the error for the given response, nil if successful:
+// CloudCasa write operations return an error payload with the OK status even upon success.
+func newAPIError(statusCode int, body *oapi.Error) error {
+	if statusCode < http.StatusBadRequest && (body == nil || body.Status == "OK") {
+		return nil
+	}
+
+	err := &APIError{StatusCode: statusCode, Code: statusCode, Message: http.StatusText(statusCode)}
+
+	if body != nil {
+		if body.Error.Code != nil {
+			err.Code = *body.Error.Code
+		}
+
+		if body.Error.Message != nil {
+			err.Message = *body.Error.Message
+		}
+	}
+
+	return err
+}
+
+func statusCode(err error) int {
+	var apiErr *APIError
+
+	if !goerr.As(err, &apiErr) {
+		return 0
+	}
+
+	return apiErr.StatusCode
+}
+
+func IsNotFound(err error) bool {
+	return statusCode(err) == http.StatusNotFound
+}
+
+// IsPreconditionFailed returns true when the resource ETag doesn't match, since it has been changed concurrently.
+func IsPreconditionFailed(err error) bool {
+	return statusCode(err) == http.StatusPreconditionFailed
+}
+
+// IsRefused returns true when the CloudCasa API server refused the request, which thus has not been performed.
+func IsRefused(err error) bool {
+	code := statusCode(err)
+
+	return code >= http.StatusBadRequest && code < http.StatusInternalServerError
+}
+
+// IsRejected returns true when the CloudCasa API server doesn't support the request at all,
+// rather than refusing the given payload, or the current credentials.
+func IsRejected(err error) bool {
+	code := statusCode(err)
+
+	return code == http.StatusMethodNotAllowed || code == http.StatusNotImplemented
+}
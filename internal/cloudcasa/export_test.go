@@ -0,0 +1,11 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudcasa
+
+import "time"
+
+// SetNow replaces the clock the Cache entries expire with.
+func (c *Cache) SetNow(now func() time.Time) {
+	c.now = now
+}
@@ -0,0 +1,786 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fake provides an in-memory implementation of the CloudCasa Service,
+// honouring the ETag preconditions as the CloudCasa API server does.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+type Service struct {
+	// RejectNamespaces refuses the manual registration of Kubenamespaces.
+	RejectNamespaces bool
+
+	mutex      sync.Mutex
+	sequence   int
+	etags      map[string]string
+	userGroups map[string]oapi.Usergroup
+	invites    map[string]oapi.Orginvite
+	users      map[string]oapi.User
+	namespaces map[string]oapi.Kubenamespace
+	clusters   map[string]oapi.Kubecluster
+	backups    map[string]oapi.Kubebackup
+	instances  map[string]oapi.Backupinstance
+	restores   map[string]oapi.Kuberestore
+	policies   map[string]oapi.Policy
+	jobs       map[string]oapi.Job
+	orgs       map[string]oapi.Org
+}
+
+var _ cloudcasa.Service = &Service{}
+
+func New() *Service {
+	return &Service{
+		etags:      map[string]string{},
+		userGroups: map[string]oapi.Usergroup{},
+		invites:    map[string]oapi.Orginvite{},
+		users:      map[string]oapi.User{},
+		namespaces: map[string]oapi.Kubenamespace{},
+		clusters:   map[string]oapi.Kubecluster{},
+		backups:    map[string]oapi.Kubebackup{},
+		instances:  map[string]oapi.Backupinstance{},
+		restores:   map[string]oapi.Kuberestore{},
+		policies:   map[string]oapi.Policy{},
+		jobs:       map[string]oapi.Job{},
+		orgs:       map[string]oapi.Org{},
+	}
+}
+
+// clone deep copies the generated models, which are made of pointers.
+func clone(in, out interface{}) {
+	value, err := json.Marshal(in)
+	if err != nil {
+		panic(err)
+	}
+
+	if err = json.Unmarshal(value, out); err != nil {
+		panic(err)
+	}
+}
+
+func notFound(kind, id string) error {
+	return &cloudcasa.APIError{StatusCode: http.StatusNotFound, Code: http.StatusNotFound, Message: fmt.Sprintf("%s %s not found", kind, id)}
+}
+
+// nextID returns a new resource ID, updating its ETag.
+func (s *Service) nextID() string {
+	s.sequence++
+
+	id := fmt.Sprintf("%024x", s.sequence)
+	s.touch(id)
+
+	return id
+}
+
+func (s *Service) touch(id string) {
+	s.sequence++
+
+	s.etags[id] = fmt.Sprintf("%x", s.sequence)
+}
+
+func (s *Service) checkETag(id, etag string) error {
+	if s.etags[id] != etag {
+		return &cloudcasa.APIError{StatusCode: http.StatusPreconditionFailed, Code: http.StatusPreconditionFailed, Message: "client and server etags don't match"}
+	}
+
+	return nil
+}
+
+func (s *Service) GetUserGroup(_ context.Context, id string) (string, *oapi.Usergroup, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	userGroup, ok := s.userGroups[id]
+	if !ok {
+		return "", nil, notFound("Usergroup", id)
+	}
+
+	out := &oapi.Usergroup{}
+	clone(userGroup, out)
+
+	return s.etags[id], out, nil
+}
+
+func (s *Service) FindUserGroup(ctx context.Context, name string) (string, *oapi.Usergroup, error) {
+	s.mutex.Lock()
+
+	var ids []string
+
+	for id, userGroup := range s.userGroups {
+		if userGroup.Name == name {
+			ids = append(ids, id)
+		}
+	}
+
+	s.mutex.Unlock()
+
+	switch {
+	case len(ids) > 1:
+		return "", nil, fmt.Errorf("multiple UserGroups with the name %s", name)
+	case len(ids) == 0:
+		return "", nil, nil
+	default:
+		return s.GetUserGroup(ctx, ids[0])
+	}
+}
+
+func (s *Service) EnsureUserGroup(ctx context.Context, name string, acls []oapi.UserGroupACL) (string, *oapi.Usergroup, error) {
+	etag, userGroup, err := s.FindUserGroup(ctx, name)
+	if err != nil || userGroup != nil {
+		return etag, userGroup, err
+	}
+
+	s.mutex.Lock()
+
+	id := s.nextID()
+
+	userGroup = &oapi.Usergroup{Id: &id, Name: name}
+	clone(acls, &userGroup.Acls)
+	s.userGroups[id] = *userGroup
+
+	s.mutex.Unlock()
+
+	return s.GetUserGroup(ctx, id)
+}
+
+func (s *Service) UpdateUserGroupACL(_ context.Context, id, etag string, acls []oapi.ACL) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	userGroup, ok := s.userGroups[id]
+	if !ok {
+		return notFound("Usergroup", id)
+	}
+
+	if err := s.checkETag(id, etag); err != nil {
+		return err
+	}
+
+	userGroupACLs := make([]oapi.UserGroupACL, 0, len(acls))
+
+	for _, acl := range acls {
+		userGroupACL := oapi.UserGroupACL{Permissions: acl.Permissions, Resource: acl.Resource, ResourceIds: acl.ResourceIds}
+
+		if acl.Roles != nil {
+			roles := make([]struct {
+				Id   *string `json:"id,omitempty"`
+				Name *string `json:"name,omitempty"`
+				Type *string `json:"type,omitempty"`
+			}, len(*acl.Roles))
+
+			for i := range *acl.Roles {
+				roles[i].Id = &(*acl.Roles)[i]
+			}
+
+			userGroupACL.Roles = &roles
+		}
+
+		userGroupACLs = append(userGroupACLs, userGroupACL)
+	}
+
+	clone(userGroupACLs, &userGroup.Acls)
+	s.userGroups[id] = userGroup
+	s.touch(id)
+
+	return nil
+}
+
+func (s *Service) PatchUserGroup(_ context.Context, id, etag string, patch oapi.Usergroup) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	userGroup, ok := s.userGroups[id]
+	if !ok {
+		return notFound("Usergroup", id)
+	}
+
+	if err := s.checkETag(id, etag); err != nil {
+		return err
+	}
+
+	if len(patch.Name) > 0 {
+		userGroup.Name = patch.Name
+	}
+
+	if patch.Users != nil {
+		clone(patch.Users, &userGroup.Users)
+	}
+
+	if patch.Tags != nil {
+		clone(patch.Tags, &userGroup.Tags)
+	}
+
+	s.userGroups[id] = userGroup
+	s.touch(id)
+
+	return nil
+}
+
+func (s *Service) DeleteUserGroup(_ context.Context, id, etag string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.userGroups[id]; !ok {
+		return nil
+	}
+
+	if err := s.checkETag(id, etag); err != nil {
+		return err
+	}
+
+	delete(s.userGroups, id)
+	delete(s.etags, id)
+
+	return nil
+}
+
+func (s *Service) ListInvites(_ context.Context, filter cloudcasa.InviteFilter) ([]oapi.Orginvite, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var items []oapi.Orginvite
+
+	for _, invite := range s.invites {
+		if len(filter.Email) > 0 && invite.Email != filter.Email {
+			continue
+		}
+
+		if len(filter.State) > 0 && (invite.State == nil || *invite.State != filter.State) {
+			continue
+		}
+
+		if !matchTags(invite.Tags, filter.Tags) {
+			continue
+		}
+
+		var item oapi.Orginvite
+		clone(invite, &item)
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func matchTags(tags *map[string]interface{}, selector map[string]string) bool {
+	for k, v := range selector {
+		if tags == nil || (*tags)[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Service) CreateInvite(_ context.Context, invite oapi.Orginvite) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id, state := s.nextID(), oapi.OrginviteStatePENDING
+
+	var item oapi.Orginvite
+	clone(invite, &item)
+
+	item.Id, item.State = &id, &state
+	s.invites[id] = item
+
+	return nil
+}
+
+func (s *Service) DeleteInvite(_ context.Context, id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.invites, id)
+	delete(s.etags, id)
+
+	return nil
+}
+
+func (s *Service) ListUsers(_ context.Context, filter cloudcasa.UserFilter) ([]oapi.User, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ids := map[string]struct{}{}
+
+	for _, id := range filter.IDs {
+		ids[id] = struct{}{}
+	}
+
+	var items []oapi.User
+
+	for id, user := range s.users {
+		if len(filter.Email) > 0 && !strings.EqualFold(user.Email, filter.Email) {
+			continue
+		}
+
+		if _, ok := ids[id]; filter.IDs != nil && !ok {
+			continue
+		}
+
+		var item oapi.User
+		clone(user, &item)
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s *Service) FindNamespace(_ context.Context, clusterID, name string) (*oapi.Kubenamespace, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, namespace := range s.namespaces {
+		if namespace.Name == name && string(namespace.ClusterId) == clusterID {
+			item := &oapi.Kubenamespace{}
+			clone(namespace, item)
+
+			return item, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Service) FindNamespaceByUID(_ context.Context, name, uid string) (*oapi.Kubenamespace, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, namespace := range s.namespaces {
+		if namespace.Name == name && namespace.K8sUid != nil && *namespace.K8sUid == uid {
+			item := &oapi.Kubenamespace{}
+			clone(namespace, item)
+
+			return item, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Service) ResolveNamespaces(_ context.Context, clusterID string, names []string) (map[string]oapi.Kubenamespace, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	wanted := make(map[string]struct{}, len(names))
+
+	for _, name := range names {
+		wanted[name] = struct{}{}
+	}
+
+	namespaces := make(map[string]oapi.Kubenamespace, len(names))
+
+	for _, namespace := range s.namespaces {
+		if _, ok := wanted[namespace.Name]; ok && string(namespace.ClusterId) == clusterID {
+			var item oapi.Kubenamespace
+			clone(namespace, &item)
+
+			namespaces[namespace.Name] = item
+		}
+	}
+
+	return namespaces, nil
+}
+
+func (s *Service) CreateNamespace(_ context.Context, namespace oapi.Kubenamespace) error {
+	if s.RejectNamespaces {
+		return &cloudcasa.APIError{StatusCode: http.StatusMethodNotAllowed, Code: http.StatusMethodNotAllowed, Message: "the requested method is not allowed"}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := s.nextID()
+
+	var item oapi.Kubenamespace
+	clone(namespace, &item)
+
+	item.Id = &id
+	s.namespaces[id] = item
+
+	return nil
+}
+
+func (s *Service) FindCluster(_ context.Context, name string) (*oapi.Kubecluster, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var found *oapi.Kubecluster
+
+	for _, cluster := range s.clusters {
+		if cluster.Name != name {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("multiple Kubeclusters with the name %s", name)
+		}
+
+		found = &oapi.Kubecluster{}
+		clone(cluster, found)
+	}
+
+	return found, nil
+}
+
+func (s *Service) GetBackup(_ context.Context, id string) (*oapi.Kubebackup, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	backup, ok := s.backups[id]
+	if !ok {
+		return nil, notFound("Kubebackup", id)
+	}
+
+	item := &oapi.Kubebackup{}
+	clone(backup, item)
+
+	return item, nil
+}
+
+func (s *Service) ListBackups(_ context.Context, filter cloudcasa.BackupFilter) ([]oapi.Kubebackup, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var items []oapi.Kubebackup
+
+	for _, backup := range s.backups {
+		if len(filter.Name) > 0 && backup.Name != filter.Name {
+			continue
+		}
+
+		if len(filter.ClusterID) > 0 && string(backup.Cluster) != filter.ClusterID {
+			continue
+		}
+
+		if !matchTags(backup.Tags, filter.Tags) {
+			continue
+		}
+
+		var item oapi.Kubebackup
+		clone(backup, &item)
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s *Service) FindBackup(ctx context.Context, name string) (*oapi.Kubebackup, error) {
+	items, err := s.ListBackups(ctx, cloudcasa.BackupFilter{Name: name})
+
+	switch {
+	case err != nil || len(items) == 0:
+		return nil, err
+	case len(items) > 1:
+		return nil, fmt.Errorf("multiple Kubebackups with the name %s", name)
+	default:
+		return &items[0], nil
+	}
+}
+
+func (s *Service) EnsureBackup(ctx context.Context, backup oapi.Kubebackup) (*oapi.Kubebackup, error) {
+	if item, err := s.FindBackup(ctx, backup.Name); err != nil || item != nil {
+		return item, err
+	}
+
+	s.mutex.Lock()
+
+	id := s.nextID()
+	backupID := oapi.KubebackupId(id)
+
+	var item oapi.Kubebackup
+	clone(backup, &item)
+
+	item.Id = &backupID
+	s.backups[id] = item
+
+	s.mutex.Unlock()
+
+	return s.FindBackup(ctx, backup.Name)
+}
+
+func (s *Service) UpdateBackup(_ context.Context, id string, patch oapi.Kubebackup) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	backup, ok := s.backups[id]
+	if !ok {
+		return notFound("Kubebackup", id)
+	}
+
+	if len(patch.Name) > 0 {
+		backup.Name = patch.Name
+	}
+
+	if len(patch.Cluster) > 0 {
+		backup.Cluster = patch.Cluster
+	}
+
+	if patch.Policy != nil {
+		clone(patch.Policy, &backup.Policy)
+	}
+
+	if patch.Pause != nil {
+		clone(patch.Pause, &backup.Pause)
+	}
+
+	if patch.Tags != nil {
+		clone(patch.Tags, &backup.Tags)
+	}
+
+	if patch.TriggerType != nil {
+		clone(patch.TriggerType, &backup.TriggerType)
+	}
+
+	clone(patch.Source, &backup.Source)
+
+	s.backups[id] = backup
+	s.touch(id)
+
+	return nil
+}
+
+func (s *Service) DeleteBackup(_ context.Context, id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.backups, id)
+	delete(s.etags, id)
+
+	return nil
+}
+
+// RunBackup schedules a RUNNING Job for the Kubebackup, which can be completed with SetJobState,
+// along with the backup instance of the Kubebackup source.
+func (s *Service) RunBackup(_ context.Context, id, name string, retentionDays int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	backup, ok := s.backups[id]
+	if !ok {
+		return notFound("Kubebackup", id)
+	}
+
+	backupID, instanceID := oapi.KubebackupId(id), oapi.BackupinstanceId(s.nextID())
+
+	instance := oapi.Backupinstance{
+		Id:            &instanceID,
+		Name:          name,
+		Backupdef:     &backupID,
+		BackupdefName: &backup.Name,
+		Cluster:       &backup.Cluster,
+		Tags:          backup.Tags,
+	}
+	clone(backup.Source, &instance.BackupSelection)
+
+	s.instances[string(instanceID)] = instance
+
+	jobID := oapi.JobId(s.nextID())
+	state, startTime := oapi.JobStateRUNNING, s.sequence
+
+	s.jobs[string(jobID)] = oapi.Job{
+		Id:            &jobID,
+		Name:          name,
+		Type:          oapi.JobTypeK8SSNAP,
+		Backupdef:     &backupID,
+		BackupdefName: &backup.Name,
+		BackupInst:    &instanceID,
+		Cluster:       &backup.Cluster,
+		State:         &state,
+		StartTime:     &startTime,
+	}
+
+	return nil
+}
+
+func (s *Service) GetBackupInstance(_ context.Context, id string) (*oapi.Backupinstance, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	instance, ok := s.instances[id]
+	if !ok {
+		return nil, notFound("Backupinstance", id)
+	}
+
+	item := &oapi.Backupinstance{}
+	clone(instance, item)
+
+	return item, nil
+}
+
+// EnsureRestore schedules a RUNNING Job for the created Kuberestore, which can be completed with SetJobState.
+func (s *Service) EnsureRestore(_ context.Context, restore oapi.Kuberestore) (*oapi.Kuberestore, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, item := range s.restores {
+		if item.Name == restore.Name {
+			out := &oapi.Kuberestore{}
+			clone(item, out)
+
+			return out, nil
+		}
+	}
+
+	if _, ok := s.instances[string(restore.BackupInst)]; !ok {
+		return nil, notFound("Backupinstance", string(restore.BackupInst))
+	}
+
+	restoreID := oapi.KuberestoreId(s.nextID())
+
+	var item oapi.Kuberestore
+	clone(restore, &item)
+
+	item.Id = &restoreID
+	s.restores[string(restoreID)] = item
+
+	jobID := oapi.JobId(s.nextID())
+	state, startTime := oapi.JobStateRUNNING, s.sequence
+
+	s.jobs[string(jobID)] = oapi.Job{
+		Id:             &jobID,
+		Name:           restore.Name,
+		Type:           oapi.JobTypeRESTORE,
+		Restoredef:     &restoreID,
+		RestoredefName: &item.Name,
+		Cluster:        restore.Cluster,
+		State:          &state,
+		StartTime:      &startTime,
+	}
+
+	out := &oapi.Kuberestore{}
+	clone(item, out)
+
+	return out, nil
+}
+
+func (s *Service) EnsurePolicy(_ context.Context, policy oapi.Policy) (*oapi.Policy, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, item := range s.policies {
+		if item.Name == policy.Name {
+			found := &oapi.Policy{}
+			clone(item, found)
+
+			return found, nil
+		}
+	}
+
+	id := s.nextID()
+	policyID := oapi.PolicyId(id)
+
+	var item oapi.Policy
+	clone(policy, &item)
+
+	item.Id = &policyID
+	s.policies[id] = item
+
+	created := &oapi.Policy{}
+	clone(item, created)
+
+	return created, nil
+}
+
+func (s *Service) ReplacePolicy(_ context.Context, id string, policy oapi.Policy) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.policies[id]; !ok {
+		return notFound("Policy", id)
+	}
+
+	var item oapi.Policy
+	clone(policy, &item)
+
+	policyID := oapi.PolicyId(id)
+	item.Id = &policyID
+
+	s.policies[id] = item
+	s.touch(id)
+
+	return nil
+}
+
+func (s *Service) DeletePolicy(_ context.Context, id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.policies, id)
+	delete(s.etags, id)
+
+	return nil
+}
+
+func (s *Service) GetJob(_ context.Context, id string) (*oapi.Job, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, notFound("Job", id)
+	}
+
+	item := &oapi.Job{}
+	clone(job, item)
+
+	return item, nil
+}
+
+func (s *Service) FindLatestJob(_ context.Context, filter cloudcasa.JobFilter) (*oapi.Job, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var latest *oapi.Job
+
+	for _, job := range s.jobs {
+		if len(filter.Name) > 0 && job.Name != filter.Name {
+			continue
+		}
+
+		if len(filter.BackupID) > 0 && (job.Backupdef == nil || string(*job.Backupdef) != filter.BackupID) {
+			continue
+		}
+
+		if len(filter.RestoreID) > 0 && (job.Restoredef == nil || string(*job.Restoredef) != filter.RestoreID) {
+			continue
+		}
+
+		if latest == nil || *job.StartTime > *latest.StartTime {
+			item := &oapi.Job{}
+			clone(job, item)
+
+			latest = item
+		}
+	}
+
+	return latest, nil
+}
+
+func (s *Service) ListOrgs(context.Context) ([]oapi.Org, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	items := make([]oapi.Org, 0, len(s.orgs))
+
+	for _, org := range s.orgs {
+		var item oapi.Org
+		clone(org, &item)
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/fake"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+func TestUserGroupETags(t *testing.T) {
+	ctx := context.Background()
+
+	service := fake.New()
+
+	etag, userGroup, err := service.EnsureUserGroup(ctx, "solar", []oapi.UserGroupACL{{Resource: "allresources"}})
+	if err != nil {
+		t.Fatalf("cannot create the UserGroup: %s", err.Error())
+	}
+
+	if _, existing, _ := service.EnsureUserGroup(ctx, "solar", nil); *existing.Id != *userGroup.Id {
+		t.Fatalf("expected the existing UserGroup %s to be returned, got %s", *userGroup.Id, *existing.Id)
+	}
+
+	if err = service.PatchUserGroup(ctx, *userGroup.Id, etag, oapi.Usergroup{Users: &[]string{"alice"}}); err != nil {
+		t.Fatalf("cannot patch the UserGroup: %s", err.Error())
+	}
+	// The ETag changed upon the update
+	if err = service.UpdateUserGroupACL(ctx, *userGroup.Id, etag, nil); !cloudcasa.IsPreconditionFailed(err) {
+		t.Fatalf("expected the stale ETag to be refused, got %v", err)
+	}
+
+	if err = service.DeleteUserGroup(ctx, *userGroup.Id, etag); !cloudcasa.IsPreconditionFailed(err) {
+		t.Fatalf("expected the stale ETag to be refused, got %v", err)
+	}
+
+	etag, userGroup, err = service.GetUserGroup(ctx, *userGroup.Id)
+	if err != nil {
+		t.Fatalf("cannot retrieve the UserGroup: %s", err.Error())
+	}
+
+	if userGroup.Users == nil || len(*userGroup.Users) != 1 || len(*userGroup.Acls) != 1 {
+		t.Fatalf("expected the patch to preserve the ACL, got %+v", userGroup)
+	}
+
+	if err = service.DeleteUserGroup(ctx, *userGroup.Id, etag); err != nil {
+		t.Fatalf("cannot delete the UserGroup: %s", err.Error())
+	}
+
+	if _, _, err = service.GetUserGroup(ctx, *userGroup.Id); !cloudcasa.IsNotFound(err) {
+		t.Fatalf("expected the UserGroup to be deleted, got %v", err)
+	}
+
+	if err = service.DeleteUserGroup(ctx, *userGroup.Id, etag); err != nil {
+		t.Fatalf("expected the deletion to be idempotent, got %s", err.Error())
+	}
+}
+
+func TestReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+
+	service := fake.New()
+
+	_, userGroup, err := service.EnsureUserGroup(ctx, "solar", []oapi.UserGroupACL{{Resource: "allresources"}})
+	if err != nil {
+		t.Fatalf("cannot create the UserGroup: %s", err.Error())
+	}
+
+	(*userGroup.Acls)[0].Resource = "kubenamespaces"
+
+	if _, stored, _ := service.GetUserGroup(ctx, *userGroup.Id); (*stored.Acls)[0].Resource != "allresources" {
+		t.Fatal("expected the stored UserGroup not to be altered by the callers")
+	}
+}
+
+func TestListFilters(t *testing.T) {
+	ctx := context.Background()
+
+	service := fake.New()
+
+	aliceID := service.AddUser("alice@clastix.io")
+	service.AddUser("bob@clastix.io")
+
+	for _, invite := range []oapi.Orginvite{
+		{Email: "alice@clastix.io", Tags: &map[string]interface{}{"tenant": "solar"}},
+		{Email: "bob@clastix.io", Tags: &map[string]interface{}{"tenant": "oil"}},
+	} {
+		if err := service.CreateInvite(ctx, invite); err != nil {
+			t.Fatalf("cannot create the invitation: %s", err.Error())
+		}
+	}
+
+	service.SetInviteState("bob@clastix.io", oapi.OrginviteStateACCEPTED)
+
+	tests := map[string]struct {
+		filter   cloudcasa.InviteFilter
+		expected string
+	}{
+		"by email": {filter: cloudcasa.InviteFilter{Email: "alice@clastix.io"}, expected: "alice@clastix.io"},
+		"by state": {filter: cloudcasa.InviteFilter{State: oapi.OrginviteStateACCEPTED}, expected: "bob@clastix.io"},
+		"by tags":  {filter: cloudcasa.InviteFilter{Tags: map[string]string{"tenant": "solar"}}, expected: "alice@clastix.io"},
+	}
+
+	for name, tc := range tests {
+		invites, err := service.ListInvites(ctx, tc.filter)
+		if err != nil {
+			t.Fatalf("%s: cannot list the invitations: %s", name, err.Error())
+		}
+
+		if len(invites) != 1 || invites[0].Email != tc.expected {
+			t.Fatalf("%s: expected the invitation of %s, got %+v", name, tc.expected, invites)
+		}
+	}
+
+	users, err := service.ListUsers(ctx, cloudcasa.UserFilter{Email: "Alice@Clastix.io"})
+	if err != nil || len(users) != 1 || string(*users[0].Id) != aliceID {
+		t.Fatalf("expected the user to be found ignoring the email case, got %+v (%v)", users, err)
+	}
+
+	if users, _ = service.ListUsers(ctx, cloudcasa.UserFilter{IDs: []string{}}); len(users) != 0 {
+		t.Fatalf("expected no users for an empty IDs filter, got %+v", users)
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	ctx := context.Background()
+
+	service := fake.New()
+
+	clusterID, otherID := service.AddCluster("solar-cluster"), service.AddCluster("oil-cluster")
+	service.AddNamespace(clusterID, "solar-prod", "prod-uid")
+	service.AddNamespace(otherID, "solar-dev", "other-uid")
+
+	uid := "dev-uid"
+	if err := service.CreateNamespace(ctx, oapi.Kubenamespace{ClusterId: oapi.KubeclusterId(clusterID), Name: "solar-dev", K8sUid: &uid}); err != nil {
+		t.Fatalf("cannot create the Kubenamespace: %s", err.Error())
+	}
+
+	resolved, err := service.ResolveNamespaces(ctx, clusterID, []string{"solar-prod", "solar-dev", "solar-test"})
+	if err != nil {
+		t.Fatalf("cannot resolve the Kubenamespaces: %s", err.Error())
+	}
+
+	if len(resolved) != 2 || *resolved["solar-dev"].K8sUid != uid {
+		t.Fatalf("expected only the Kubenamespaces of the cluster to be resolved, got %+v", resolved)
+	}
+
+	service.RejectNamespaces = true
+
+	if err = service.CreateNamespace(ctx, oapi.Kubenamespace{ClusterId: oapi.KubeclusterId(clusterID), Name: "solar-test"}); !cloudcasa.IsRejected(err) {
+		t.Fatalf("expected the registration to be rejected, got %v", err)
+	}
+}
+
+func TestBackupJobs(t *testing.T) {
+	ctx := context.Background()
+
+	service := fake.New()
+
+	clusterID := service.AddCluster("solar-cluster")
+
+	backup, err := service.EnsureBackup(ctx, oapi.Kubebackup{
+		Name:    "solar-backup",
+		Cluster: oapi.KubeclusterId(clusterID),
+		Tags:    &map[string]interface{}{"tenant": "solar"},
+	})
+	if err != nil {
+		t.Fatalf("cannot create the Kubebackup: %s", err.Error())
+	}
+
+	for _, name := range []string{"first", "second"} {
+		if err = service.RunBackup(ctx, string(*backup.Id), name, 7); err != nil {
+			t.Fatalf("cannot run the Kubebackup: %s", err.Error())
+		}
+	}
+
+	job, err := service.FindLatestJob(ctx, cloudcasa.JobFilter{BackupID: string(*backup.Id)})
+	if err != nil || job == nil || job.Name != "second" || *job.State != oapi.JobStateRUNNING {
+		t.Fatalf("expected the latest running Job to be found, got %+v (%v)", job, err)
+	}
+
+	service.SetJobState(string(*job.Id), oapi.JobStateCOMPLETED)
+
+	if job, _ = service.GetJob(ctx, string(*job.Id)); *job.State != oapi.JobStateCOMPLETED {
+		t.Fatalf("expected the Job to be completed, got %s", *job.State)
+	}
+
+	instance, err := service.GetBackupInstance(ctx, string(*job.BackupInst))
+	if err != nil {
+		t.Fatalf("cannot retrieve the backup instance: %s", err.Error())
+	}
+
+	if string(*instance.Cluster) != clusterID || (*instance.Tags)["tenant"] != "solar" {
+		t.Fatalf("expected the backup instance to inherit the Kubebackup cluster and tags, got %+v", instance)
+	}
+
+	if _, err = service.EnsureRestore(ctx, oapi.Kuberestore{Name: "missing", BackupInst: "unknown"}); !cloudcasa.IsNotFound(err) {
+		t.Fatalf("expected the restore of an unknown backup instance to fail, got %v", err)
+	}
+
+	restore, err := service.EnsureRestore(ctx, oapi.Kuberestore{Name: "solar-restore", BackupInst: *instance.Id})
+	if err != nil {
+		t.Fatalf("cannot create the Kuberestore: %s", err.Error())
+	}
+
+	job, err = service.FindLatestJob(ctx, cloudcasa.JobFilter{RestoreID: string(*restore.Id)})
+	if err != nil || job == nil || job.Type != oapi.JobTypeRESTORE {
+		t.Fatalf("expected the restore Job to be found, got %+v (%v)", job, err)
+	}
+}
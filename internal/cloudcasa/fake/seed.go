@@ -0,0 +1,106 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// AddOrg creates an Organization, returning its ID.
+func (s *Service) AddOrg(name string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := s.nextID()
+	orgID := oapi.OrgId(id)
+
+	s.orgs[id] = oapi.Org{Id: &orgID, Name: name}
+
+	return id
+}
+
+// AddUser creates a User, returning its ID.
+func (s *Service) AddUser(email string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := s.nextID()
+	userID := oapi.UserId(id)
+
+	s.users[id] = oapi.User{Id: &userID, Email: email, Name: email}
+
+	return id
+}
+
+// AddCluster creates a Kubecluster, returning its ID.
+func (s *Service) AddCluster(name string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := s.nextID()
+	clusterID := oapi.KubeclusterId(id)
+
+	s.clusters[id] = oapi.Kubecluster{Id: &clusterID, Name: name}
+
+	return id
+}
+
+// AddNamespace creates a Kubenamespace as discovered by the CloudCasa agent, returning its ID.
+func (s *Service) AddNamespace(clusterID, name, uid string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := s.nextID()
+
+	s.namespaces[id] = oapi.Kubenamespace{Id: &id, ClusterId: oapi.KubeclusterId(clusterID), Name: name, K8sUid: &uid}
+
+	return id
+}
+
+// SetInviteState changes the state of the Orginvite sent to the given email, as the invited user does.
+func (s *Service) SetInviteState(email string, state oapi.OrginviteState) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id, invite := range s.invites {
+		if invite.Email != email {
+			continue
+		}
+
+		invite.State = &state
+		s.invites[id] = invite
+		s.touch(id)
+	}
+}
+
+// UserGroups returns the stored UserGroups.
+func (s *Service) UserGroups() []oapi.Usergroup {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	items := make([]oapi.Usergroup, 0, len(s.userGroups))
+
+	for _, userGroup := range s.userGroups {
+		var item oapi.Usergroup
+		clone(userGroup, &item)
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// SetJobState changes the state of the Job, as the CloudCasa job runner does.
+func (s *Service) SetJobState(id string, state oapi.JobState) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return
+	}
+
+	job.State = &state
+	s.jobs[id] = job
+}
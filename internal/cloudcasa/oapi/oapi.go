@@ -21,6 +21,130 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for BackupinstanceAwsrdsSnapshotType.
+const (
+	BackupinstanceAwsrdsSnapshotTypeAWSAuto BackupinstanceAwsrdsSnapshotType = "AWS Auto"
+
+	BackupinstanceAwsrdsSnapshotTypeAWSManual BackupinstanceAwsrdsSnapshotType = "AWS Manual"
+
+	BackupinstanceAwsrdsSnapshotTypeCloudCasa BackupinstanceAwsrdsSnapshotType = "CloudCasa"
+)
+
+// Defines values for BackupinstanceSourceType.
+const (
+	BackupinstanceSourceTypeAwsrds BackupinstanceSourceType = "awsrds"
+
+	BackupinstanceSourceTypeKubernetes BackupinstanceSourceType = "kubernetes"
+)
+
+// Defines values for BackupinstanceState.
+const (
+	BackupinstanceStateDELETING BackupinstanceState = "DELETING"
+
+	BackupinstanceStatePENDING BackupinstanceState = "PENDING"
+
+	BackupinstanceStateREADY BackupinstanceState = "READY"
+)
+
+// Defines values for BackupinstanceType.
+const (
+	BackupinstanceTypeCOPY BackupinstanceType = "COPY"
+
+	BackupinstanceTypeSNAPSHOT BackupinstanceType = "SNAPSHOT"
+)
+
+// Defines values for JobPhase.
+const (
+	JobPhaseOFFLOADCOMPLETED JobPhase = "OFFLOAD_COMPLETED"
+
+	JobPhaseOFFLOADPARTIAL JobPhase = "OFFLOAD_PARTIAL"
+
+	JobPhaseOFFLOADRUNNING JobPhase = "OFFLOAD_RUNNING"
+)
+
+// Defines values for JobState.
+const (
+	JobStateCANCELED JobState = "CANCELED"
+
+	JobStateCANCELLING JobState = "CANCELLING"
+
+	JobStateCATALOG JobState = "CATALOG"
+
+	JobStateCOMPLETED JobState = "COMPLETED"
+
+	JobStateFAILED JobState = "FAILED"
+
+	JobStatePARTIAL JobState = "PARTIAL"
+
+	JobStatePENDING JobState = "PENDING"
+
+	JobStateRUNNING JobState = "RUNNING"
+
+	JobStateSKIPPED JobState = "SKIPPED"
+
+	JobStateUNKNOWN JobState = "UNKNOWN"
+)
+
+// Defines values for JobType.
+const (
+	JobTypeAGENTUPDATE JobType = "AGENT_UPDATE"
+
+	JobTypeAWSEKSRESTORE JobType = "AWSEKS_RESTORE"
+
+	JobTypeAWSINVENTORY JobType = "AWS_INVENTORY"
+
+	JobTypeAWSRDSBACKUP JobType = "AWSRDS_BACKUP"
+
+	JobTypeAWSRDSBACKUPDELETE JobType = "AWSRDS_BACKUP_DELETE"
+
+	JobTypeAWSRDSCOPY JobType = "AWSRDS_COPY"
+
+	JobTypeAWSRDSRESTORE JobType = "AWSRDS_RESTORE"
+
+	JobTypeCLOUDINVENTORY JobType = "CLOUD_INVENTORY"
+
+	JobTypeDELETEBACKUP JobType = "DELETE_BACKUP"
+
+	JobTypeK8SCOPY JobType = "K8S_COPY"
+
+	JobTypeK8SSNAP JobType = "K8S_SNAP"
+
+	JobTypeKOPIACONDENSE JobType = "KOPIACONDENSE"
+
+	JobTypeRESTORE JobType = "RESTORE"
+
+	JobTypeSECURITYSCAN JobType = "SECURITY_SCAN"
+)
+
+// Defines values for KubebackupTriggerType.
+const (
+	KubebackupTriggerTypeADHOC KubebackupTriggerType = "ADHOC"
+
+	KubebackupTriggerTypeSCHEDULED KubebackupTriggerType = "SCHEDULED"
+)
+
+// Defines values for KubeclusterStatusState.
+const (
+	KubeclusterStatusStateACTIVE KubeclusterStatusState = "ACTIVE"
+
+	KubeclusterStatusStateDISCOVERED KubeclusterStatusState = "DISCOVERED"
+
+	KubeclusterStatusStateINVENTORY KubeclusterStatusState = "INVENTORY"
+
+	KubeclusterStatusStatePENDING KubeclusterStatusState = "PENDING"
+
+	KubeclusterStatusStateREGISTERED KubeclusterStatusState = "REGISTERED"
+)
+
+// Defines values for KuberestoreStatusState.
+const (
+	KuberestoreStatusStateFAILED KuberestoreStatusState = "FAILED"
+
+	KuberestoreStatusStateJOBCREATED KuberestoreStatusState = "JOB_CREATED"
+
+	KuberestoreStatusStatePENDING KuberestoreStatusState = "PENDING"
+)
+
 // Defines values for OrginviteState.
 const (
 	OrginviteStateACCEPTED OrginviteState = "ACCEPTED"
@@ -49,9 +173,138 @@ type ACLs struct {
 // ApikeyId defines model for Apikey__id.
 type ApikeyId string
 
+// AwsaccountId defines model for Awsaccount__id.
+type AwsaccountId string
+
+// AwseksclusterId defines model for Awsekscluster__id.
+type AwseksclusterId string
+
+// AwsrdsbackupId defines model for Awsrdsbackup__id.
+type AwsrdsbackupId string
+
+// AwsrdscopieId defines model for Awsrdscopie__id.
+type AwsrdscopieId string
+
+// AwsrdsdatabaseId defines model for Awsrdsdatabase__id.
+type AwsrdsdatabaseId string
+
+// AwsrdsrestoreId defines model for Awsrdsrestore__id.
+type AwsrdsrestoreId string
+
+// AwsrdssnapshotId defines model for Awsrdssnapshot__id.
+type AwsrdssnapshotId string
+
+// AwsuserId defines model for Awsuser__id.
+type AwsuserId string
+
+// AzureaksclusterId defines model for Azureakscluster__id.
+type AzureaksclusterId string
+
+// AzureresourcegroupId defines model for Azureresourcegroup__id.
+type AzureresourcegroupId string
+
+// Backupinstance defines model for Backupinstance.
+type Backupinstance struct {
+	Id     *BackupinstanceId `json:"_id,omitempty"`
+	Awseks *struct {
+		Awsaccount     *AwsaccountId    `json:"awsaccount,omitempty"`
+		AwsaccountName *string          `json:"awsaccount_name,omitempty"`
+		Awsekscluster  *AwseksclusterId `json:"awsekscluster,omitempty"`
+		Region         *string          `json:"region,omitempty"`
+	} `json:"awseks,omitempty"`
+	Awsrds *struct {
+		AutomatedSnapshot *AwsrdssnapshotId                 `json:"automated_snapshot,omitempty"`
+		Awsaccount        *AwsaccountId                     `json:"awsaccount,omitempty"`
+		AwsaccountName    *string                           `json:"awsaccount_name,omitempty"`
+		Backupdef         *AwsrdsbackupId                   `json:"backupdef,omitempty"`
+		Database          *AwsrdsdatabaseId                 `json:"database,omitempty"`
+		Region            *string                           `json:"region,omitempty"`
+		SnapshotSize      *int                              `json:"snapshot_size,omitempty"`
+		SnapshotType      *BackupinstanceAwsrdsSnapshotType `json:"snapshot_type,omitempty"`
+	} `json:"awsrds,omitempty"`
+	AzureAks *struct {
+		AzureAksCluster *AzureaksclusterId `json:"azure_aks_cluster,omitempty"`
+		Region          *string            `json:"region,omitempty"`
+	} `json:"azure_aks,omitempty"`
+	BackupJobId    *string `json:"backup_job_id,omitempty"`
+	BackupLocation struct {
+		BackupProvider   *string `json:"backup_provider,omitempty"`
+		BackupRepoPrefix *string `json:"backup_repo_prefix,omitempty"`
+		BucketName       *string `json:"bucket_name,omitempty"`
+		Endpoint         *string `json:"endpoint,omitempty"`
+		Prefix           *string `json:"prefix,omitempty"`
+		Region           *string `json:"region,omitempty"`
+		RepoPassword     *string `json:"repo_password,omitempty"`
+	} `json:"backup_location"`
+	BackupSelection *struct {
+		AllNamespaces             *bool                   `json:"all_namespaces,omitempty"`
+		LabelSelector             *map[string]interface{} `json:"label_selector,omitempty"`
+		Namespaces                *[]string               `json:"namespaces,omitempty"`
+		SnapshotPersistentVolumes *bool                   `json:"snapshotPersistentVolumes,omitempty"`
+	} `json:"backup_selection,omitempty"`
+	BackupStorageSize *int            `json:"backup_storage_size,omitempty"`
+	Backupdef         *KubebackupId   `json:"backupdef,omitempty"`
+	BackupdefName     *string         `json:"backupdef_name,omitempty"`
+	CcUserEmail       *string         `json:"cc_user_email,omitempty"`
+	Cloudaccount      *CloudaccountId `json:"cloudaccount,omitempty"`
+	Cluster           *KubeclusterId  `json:"cluster,omitempty"`
+	ClusterName       *string         `json:"cluster_name,omitempty"`
+	EndTime           *int            `json:"end_time,omitempty"`
+	KubeagentInfo     *struct {
+		FormatVersion *string `json:"format_version,omitempty"`
+		Phase         *string `json:"phase,omitempty"`
+	} `json:"kubeagent_info,omitempty"`
+	Message                     *string        `json:"message,omitempty"`
+	Name                        string         `json:"name"`
+	NumBackupResources          *int           `json:"num_backup_resources,omitempty"`
+	NumErrors                   *int           `json:"num_errors,omitempty"`
+	NumTotalResources           *int           `json:"num_total_resources,omitempty"`
+	NumVolumeSnapshotsAttempted *int           `json:"num_volume_snapshots_attempted,omitempty"`
+	NumVolumeSnapshotsCompleted *int           `json:"num_volume_snapshots_completed,omitempty"`
+	NumWarnings                 *int           `json:"num_warnings,omitempty"`
+	Objectstore                 *ObjectstoreId `json:"objectstore,omitempty"`
+	OffloadDef                  *KubeoffloadId `json:"offload_def,omitempty"`
+	OffloadSnapshotJobId        *string        `json:"offload_snapshot_job_id,omitempty"`
+	ProtectedStorageSize        *int           `json:"protected_storage_size,omitempty"`
+	PvCount                     *int           `json:"pv_count,omitempty"`
+	Retention                   *struct {
+		NumAlwaysRetain *int `json:"numAlwaysRetain,omitempty"`
+		RetainDays      int  `json:"retainDays"`
+	} `json:"retention,omitempty"`
+	SourceBackupinst *BackupinstanceId         `json:"source_backupinst,omitempty"`
+	SourceType       *BackupinstanceSourceType `json:"source_type,omitempty"`
+	StartTime        *int                      `json:"start_time,omitempty"`
+	State            *BackupinstanceState      `json:"state,omitempty"`
+	Status           *struct {
+		BackupSizeSet *bool `json:"backup_size_set,omitempty"`
+		Deleted       *bool `json:"deleted,omitempty"`
+		Locked        *bool `json:"locked,omitempty"`
+		S3DirDeleted  *bool `json:"s3_dir_deleted,omitempty"`
+	} `json:"status,omitempty"`
+	Tags              *map[string]interface{} `json:"tags,omitempty"`
+	TotalCopyData     *int                    `json:"total_copy_data,omitempty"`
+	TotalSnapshotData *int                    `json:"total_snapshot_data,omitempty"`
+	Type              *BackupinstanceType     `json:"type,omitempty"`
+}
+
+// BackupinstanceAwsrdsSnapshotType defines model for Backupinstance.Awsrds.SnapshotType.
+type BackupinstanceAwsrdsSnapshotType string
+
+// BackupinstanceSourceType defines model for Backupinstance.SourceType.
+type BackupinstanceSourceType string
+
+// BackupinstanceState defines model for Backupinstance.State.
+type BackupinstanceState string
+
+// BackupinstanceType defines model for Backupinstance.Type.
+type BackupinstanceType string
+
 // BackupinstanceId defines model for Backupinstance__id.
 type BackupinstanceId string
 
+// CloudaccountId defines model for Cloudaccount__id.
+type CloudaccountId string
+
 // Error defines model for Error.
 type Error struct {
 	Error struct {
@@ -70,6 +323,190 @@ type Internalacl struct {
 	User        *UserId                 `json:"user,omitempty"`
 }
 
+// Job defines model for Job.
+type Job struct {
+	Id       *JobId    `json:"_id,omitempty"`
+	Activity *[]string `json:"activity,omitempty"`
+	Awsebs   *struct {
+		Awsaccount     *AwsaccountId `json:"awsaccount,omitempty"`
+		AwsaccountName *string       `json:"awsaccount_name,omitempty"`
+	} `json:"awsebs,omitempty"`
+	Awseks *struct {
+		Awsaccount     *AwsaccountId    `json:"awsaccount,omitempty"`
+		AwsaccountName *string          `json:"awsaccount_name,omitempty"`
+		Awsekscluster  *AwseksclusterId `json:"awsekscluster,omitempty"`
+		Restoredef     *string          `json:"restoredef,omitempty"`
+	} `json:"awseks,omitempty"`
+	Awsrds *struct {
+		Awsaccount     *AwsaccountId    `json:"awsaccount,omitempty"`
+		AwsaccountName *string          `json:"awsaccount_name,omitempty"`
+		Backupdef      *AwsrdsbackupId  `json:"backupdef,omitempty"`
+		Copydef        *AwsrdscopieId   `json:"copydef,omitempty"`
+		Databases      *[]string        `json:"databases,omitempty"`
+		Restoredef     *AwsrdsrestoreId `json:"restoredef,omitempty"`
+	} `json:"awsrds,omitempty"`
+	AzureAks *struct {
+		AzureAksCluster *AzureaksclusterId `json:"azure_aks_cluster,omitempty"`
+	} `json:"azure_aks,omitempty"`
+	BackupInst       *BackupinstanceId `json:"backup_inst,omitempty"`
+	Backupdef        *KubebackupId     `json:"backupdef,omitempty"`
+	BackupdefName    *string           `json:"backupdef_name,omitempty"`
+	CcUserEmail      *string           `json:"cc_user_email,omitempty"`
+	Cloudaccount     *CloudaccountId   `json:"cloudaccount,omitempty"`
+	Cluster          *KubeclusterId    `json:"cluster,omitempty"`
+	ClusterName      *string           `json:"cluster_name,omitempty"`
+	EndTime          *int              `json:"end_time,omitempty"`
+	Jobrunner        string            `json:"jobrunner"`
+	KubeCopyProgress *struct {
+		ActivePvs    *int `json:"active_pvs,omitempty"`
+		CompletedPvs *int `json:"completed_pvs,omitempty"`
+		FailedPvs    *int `json:"failed_pvs,omitempty"`
+		PendingPvs   *int `json:"pending_pvs,omitempty"`
+		Stats        *struct {
+			EstimatedNumberOfFiles   *int `json:"estimated_number_of_files,omitempty"`
+			EstimatedSize            *int `json:"estimated_size,omitempty"`
+			IoNumberOfFiles          *int `json:"io_number_of_files,omitempty"`
+			IoSize                   *int `json:"io_size,omitempty"`
+			TransferredNumberOfFiles *int `json:"transferred_number_of_files,omitempty"`
+			TransferredSize          *int `json:"transferred_size,omitempty"`
+		} `json:"stats,omitempty"`
+		TotalPvs *int `json:"total_pvs,omitempty"`
+	} `json:"kube_copy_progress,omitempty"`
+	KubeSnapshotProgress *struct {
+		ActivePvs    *int `json:"active_pvs,omitempty"`
+		CompletedPvs *int `json:"completed_pvs,omitempty"`
+		FailedPvs    *int `json:"failed_pvs,omitempty"`
+		PendingPvs   *int `json:"pending_pvs,omitempty"`
+		SkippedPvs   *int `json:"skipped_pvs,omitempty"`
+		TotalPvs     *int `json:"total_pvs,omitempty"`
+	} `json:"kube_snapshot_progress,omitempty"`
+	Message                     *string           `json:"message,omitempty"`
+	Name                        string            `json:"name"`
+	NumBackupResources          *int              `json:"num_backup_resources,omitempty"`
+	NumErrors                   *int              `json:"num_errors,omitempty"`
+	NumRestoredResources        *int              `json:"num_restored_resources,omitempty"`
+	NumSnapshotOffloadAttempted *int              `json:"num_snapshot_offload_attempted,omitempty"`
+	NumSnapshotOffloadCompleted *int              `json:"num_snapshot_offload_completed,omitempty"`
+	NumTotalResources           *int              `json:"num_total_resources,omitempty"`
+	NumTotalSnapshots           *int              `json:"num_total_snapshots,omitempty"`
+	NumVolumeSnapshotsAttempted *int              `json:"num_volume_snapshots_attempted,omitempty"`
+	NumVolumeSnapshotsCompleted *int              `json:"num_volume_snapshots_completed,omitempty"`
+	NumWarnings                 *int              `json:"num_warnings,omitempty"`
+	OffloadBackupInst           *BackupinstanceId `json:"offload_backup_inst,omitempty"`
+	Offloaddef                  *KubeoffloadId    `json:"offloaddef,omitempty"`
+	OffloaddefName              *string           `json:"offloaddef_name,omitempty"`
+	Phase                       *JobPhase         `json:"phase,omitempty"`
+	PolicyId                    *PolicyId         `json:"policy_id,omitempty"`
+	ProcessLogs                 *bool             `json:"process_logs,omitempty"`
+	RecordOffloadSnapshotJob    *bool             `json:"record_offload_snapshot_job,omitempty"`
+	Restoredef                  *KuberestoreId    `json:"restoredef,omitempty"`
+	RestoredefName              *string           `json:"restoredef_name,omitempty"`
+	Retention                   *struct {
+		NumAlwaysRetain *int `json:"numAlwaysRetain,omitempty"`
+		RetainDays      *int `json:"retainDays,omitempty"`
+	} `json:"retention,omitempty"`
+	SecurityScanDef  *SecurityscanId         `json:"security_scan_def,omitempty"`
+	SecurityScanInst *SecurityscaninstanceId `json:"security_scan_inst,omitempty"`
+	SecurityscanName *string                 `json:"securityscan_name,omitempty"`
+	StartTime        *int                    `json:"start_time,omitempty"`
+	State            *JobState               `json:"state,omitempty"`
+	Tags             *map[string]interface{} `json:"tags,omitempty"`
+	Type             JobType                 `json:"type"`
+}
+
+// JobPhase defines model for Job.Phase.
+type JobPhase string
+
+// JobState defines model for Job.State.
+type JobState string
+
+// JobType defines model for Job.Type.
+type JobType string
+
+// JobId defines model for Job__id.
+type JobId string
+
+// Kubebackup defines model for Kubebackup.
+type Kubebackup struct {
+	Id          *KubebackupId  `json:"_id,omitempty"`
+	CcUserEmail *string        `json:"cc_user_email,omitempty"`
+	Cluster     KubeclusterId  `json:"cluster"`
+	CopyPolicy  *PolicyId      `json:"copy_policy,omitempty"`
+	Copydef     *KubeoffloadId `json:"copydef,omitempty"`
+	Name        string         `json:"name"`
+	Pause       *bool          `json:"pause,omitempty"`
+	Policy      *PolicyId      `json:"policy,omitempty"`
+	PostHooks   *[]struct {
+		Hooks      *[]string `json:"hooks,omitempty"`
+		Namespaces *[]string `json:"namespaces,omitempty"`
+		Template   *bool     `json:"template,omitempty"`
+	} `json:"post_hooks,omitempty"`
+	PreHooks *[]struct {
+		Hooks      *[]string `json:"hooks,omitempty"`
+		Namespaces *[]string `json:"namespaces,omitempty"`
+		Template   *bool     `json:"template,omitempty"`
+	} `json:"pre_hooks,omitempty"`
+	Source struct {
+		AllNamespaces             *bool                   `json:"all_namespaces,omitempty"`
+		LabelSelector             *map[string]interface{} `json:"label_selector,omitempty"`
+		Namespaces                *[]string               `json:"namespaces,omitempty"`
+		SnapshotPersistentVolumes *bool                   `json:"snapshotPersistentVolumes,omitempty"`
+	} `json:"source"`
+	Status *struct {
+		Jobs *[]struct {
+			Jobid   string  `json:"jobid"`
+			Message *string `json:"message,omitempty"`
+			State   *string `json:"state,omitempty"`
+		} `json:"jobs,omitempty"`
+		Message *string `json:"message,omitempty"`
+	} `json:"status,omitempty"`
+	Tags        *map[string]interface{} `json:"tags,omitempty"`
+	TriggerType *KubebackupTriggerType  `json:"trigger_type,omitempty"`
+}
+
+// KubebackupTriggerType defines model for Kubebackup.TriggerType.
+type KubebackupTriggerType string
+
+// KubebackupId defines model for Kubebackup__id.
+type KubebackupId string
+
+// Kubecluster defines model for Kubecluster.
+type Kubecluster struct {
+	Id             *KubeclusterId `json:"_id,omitempty"`
+	BackupProvider *struct {
+		Region          *string        `json:"region"`
+		Type            *string        `json:"type"`
+		UserObjectstore *ObjectstoreId `json:"user_objectstore,omitempty"`
+	} `json:"backup_provider,omitempty"`
+	CcUserEmail   *string                 `json:"cc_user_email,omitempty"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
+	Description   *string                 `json:"description,omitempty"`
+	Name          string                  `json:"name"`
+	Status        *struct {
+		AgentURL                *string                 `json:"agentURL,omitempty"`
+		AgentVersion            *int                    `json:"agentVersion,omitempty"`
+		AwseksclusterArn        *string                 `json:"awsekscluster_arn"`
+		AzureAksClusterId       *string                 `json:"azure_aks_cluster_id"`
+		Cloudaccount            *CloudaccountId         `json:"cloudaccount,omitempty"`
+		DeploymentPlatform      *string                 `json:"deployment_platform,omitempty"`
+		Dormant                 *bool                   `json:"dormant,omitempty"`
+		KubeAgentManagerVersion *string                 `json:"kubeAgentManagerVersion,omitempty"`
+		KubeAgentVersion        *string                 `json:"kubeAgentVersion,omitempty"`
+		Message                 *string                 `json:"message,omitempty"`
+		NumRecoveryPoints       *int                    `json:"num_recovery_points,omitempty"`
+		Otp                     *string                 `json:"otp,omitempty"`
+		PendingStateTimestamp   *int                    `json:"pendingStateTimestamp,omitempty"`
+		ScanCount               *int                    `json:"scan_count,omitempty"`
+		State                   *KubeclusterStatusState `json:"state,omitempty"`
+		UpdateTime              *int                    `json:"updateTime,omitempty"`
+		Version                 *string                 `json:"version,omitempty"`
+	} `json:"status,omitempty"`
+	Tags *map[string]interface{} `json:"tags,omitempty"`
+}
+
+// KubeclusterStatusState defines model for Kubecluster.Status.State.
+type KubeclusterStatusState string
+
 // KubeclusterId defines model for Kubecluster__id.
 type KubeclusterId string
 
@@ -84,6 +521,85 @@ type Kubenamespace struct {
 	Tags         *map[string]interface{} `json:"tags,omitempty"`
 }
 
+// KubeoffloadId defines model for Kubeoffload__id.
+type KubeoffloadId string
+
+// Kuberestore defines model for Kuberestore.
+type Kuberestore struct {
+	Id     *KuberestoreId `json:"_id,omitempty"`
+	Awseks *struct {
+		Awsaccount       AwsaccountId `json:"awsaccount"`
+		Awsuser          AwsuserId    `json:"awsuser"`
+		ClusterName      string       `json:"cluster_name"`
+		ClusterRoleArn   *string      `json:"cluster_role_arn,omitempty"`
+		NodeGroupRoleArn *string      `json:"node_group_role_arn,omitempty"`
+		Region           *string      `json:"region,omitempty"`
+		SecurityGroups   *[]string    `json:"security_groups,omitempty"`
+		Subnets          *[]string    `json:"subnets,omitempty"`
+	} `json:"awseks,omitempty"`
+	AzureAks *struct {
+		AzureAccount     CloudaccountId        `json:"azure_account"`
+		ClusterName      string                `json:"cluster_name"`
+		Region           *string               `json:"region,omitempty"`
+		ResourceGroupId  *AzureresourcegroupId `json:"resource_group_id,omitempty"`
+		ServicePrincipal struct {
+			ClientId     string `json:"client_id"`
+			ClientSecret string `json:"client_secret"`
+		} `json:"service_principal"`
+		WindowsProfile *struct {
+			AdminPassword string `json:"admin_password"`
+		} `json:"windows_profile,omitempty"`
+	} `json:"azure_aks,omitempty"`
+	BackupInst       BackupinstanceId        `json:"backup_inst"`
+	CcUserEmail      *string                 `json:"cc_user_email,omitempty"`
+	Cloudaccount     *CloudaccountId         `json:"cloudaccount,omitempty"`
+	Cluster          *KubeclusterId          `json:"cluster,omitempty"`
+	Name             string                  `json:"name"`
+	NamespacesMap    *map[string]interface{} `json:"namespaces_map,omitempty"`
+	NamespacesPrefix *string                 `json:"namespaces_prefix,omitempty"`
+	NamespacesSuffix *string                 `json:"namespaces_suffix,omitempty"`
+	Options          *struct {
+		DownloadSpeedLimit *int    `json:"download_speed_limit,omitempty"`
+		MaxMoverPvcs       *int    `json:"max_mover_pvcs,omitempty"`
+		MoverReadyTimeout  *string `json:"mover_ready_timeout,omitempty"`
+		PvcFileParallelism *int    `json:"pvc_file_parallelism,omitempty"`
+		PvcParallelism     *int    `json:"pvc_parallelism,omitempty"`
+		ReportInterval     *string `json:"report_interval,omitempty"`
+		UploadSpeedLimit   *int    `json:"upload_speed_limit,omitempty"`
+	} `json:"options,omitempty"`
+	PostHooks *[]struct {
+		Hooks      *[]string `json:"hooks,omitempty"`
+		Namespaces *[]string `json:"namespaces,omitempty"`
+		Template   *bool     `json:"template,omitempty"`
+	} `json:"post_hooks,omitempty"`
+	PreHooks *[]struct {
+		Hooks      *[]string `json:"hooks,omitempty"`
+		Namespaces *[]string `json:"namespaces,omitempty"`
+		Template   *bool     `json:"template,omitempty"`
+	} `json:"pre_hooks,omitempty"`
+	Selection struct {
+		AllNamespaces            *bool                   `json:"all_namespaces,omitempty"`
+		IncludeResourceTypes     *[]string               `json:"include_resource_types,omitempty"`
+		LabelSelector            *map[string]interface{} `json:"label_selector,omitempty"`
+		Namespaces               *[]string               `json:"namespaces,omitempty"`
+		RestorePersistentVolumes *bool                   `json:"restorePersistentVolumes,omitempty"`
+	} `json:"selection"`
+	SourceCluster *string `json:"source_cluster,omitempty"`
+	Status        *struct {
+		Jobid   *string                 `json:"jobid,omitempty"`
+		Message *string                 `json:"message,omitempty"`
+		State   *KuberestoreStatusState `json:"state,omitempty"`
+	} `json:"status,omitempty"`
+	StorageClassMap *map[string]interface{} `json:"storage_class_map,omitempty"`
+	Tags            *map[string]interface{} `json:"tags,omitempty"`
+}
+
+// KuberestoreStatusState defines model for Kuberestore.Status.State.
+type KuberestoreStatusState string
+
+// KuberestoreId defines model for Kuberestore__id.
+type KuberestoreId string
+
 // ObjectstoreId defines model for Objectstore__id.
 type ObjectstoreId string
 
@@ -147,6 +663,25 @@ type Orginvite struct {
 // OrginviteState defines model for Orginvite.State.
 type OrginviteState string
 
+// Runkubebackup defines model for Runkubebackup.
+type Runkubebackup struct {
+	Id          *string      `json:"_id,omitempty"`
+	Backup      KubebackupId `json:"backup"`
+	CcUserEmail *string      `json:"cc_user_email,omitempty"`
+	Name        string       `json:"name"`
+	Retention   struct {
+		NumAlwaysRetain *int `json:"numAlwaysRetain,omitempty"`
+		RetainDays      int  `json:"retainDays"`
+	} `json:"retention"`
+	Tags *map[string]interface{} `json:"tags,omitempty"`
+}
+
+// SecurityscanId defines model for Securityscan__id.
+type SecurityscanId string
+
+// SecurityscaninstanceId defines model for Securityscaninstance__id.
+type SecurityscaninstanceId string
+
 // User defines model for User.
 type User struct {
 	Id          *UserId `json:"_id,omitempty"`
@@ -190,6 +725,34 @@ type Usergroup struct {
 	Users       *[]string               `json:"users,omitempty"`
 }
 
+// Policy defines model for policy.
+type Policy struct {
+	Id          *PolicyId `json:"_id,omitempty"`
+	CcUserEmail *string   `json:"cc_user_email,omitempty"`
+	Name        string    `json:"name"`
+	Schedules   []struct {
+		Locked          *bool `json:"locked,omitempty"`
+		NumAlwaysRetain *int  `json:"numAlwaysRetain,omitempty"`
+		RetainDays      int   `json:"retainDays"`
+		Schedule        struct {
+			CronSpec   *string `json:"cronSpec,omitempty"`
+			DayOfMonth *string `json:"dayOfMonth,omitempty"`
+			DayOfWeek  *string `json:"dayOfWeek,omitempty"`
+			Hour       *string `json:"hour,omitempty"`
+			Minute     *string `json:"minute,omitempty"`
+			Month      *string `json:"month,omitempty"`
+		} `json:"schedule"`
+	} `json:"schedules"`
+	Status *struct {
+		Suspended *bool `json:"suspended,omitempty"`
+	} `json:"status,omitempty"`
+	Tags     *map[string]interface{} `json:"tags,omitempty"`
+	Timezone *string                 `json:"timezone,omitempty"`
+}
+
+// PolicyId defines model for policy__id.
+type PolicyId string
+
 // ResponeLinks defines model for respone_links.
 type ResponeLinks struct {
 	Parent *struct {
@@ -221,6 +784,9 @@ type KubenamespaceId string
 // OrginviteId defines model for Orginvite__id.
 type OrginviteId string
 
+// RunkubebackupId defines model for Runkubebackup__id.
+type RunkubebackupId string
+
 // UsergroupId defines model for Usergroup__id.
 type UsergroupId string
 
@@ -239,6 +805,42 @@ type QuerySort string
 // QueryWhere defines model for query__where.
 type QueryWhere string
 
+// Getv1backupinstancesParams defines parameters for Getv1backupinstances.
+type Getv1backupinstancesParams struct {
+	// the filters query parameter (ex.: {"number": 10})
+	Where *QueryWhere `json:"where,omitempty"`
+
+	// the projections query parameter (ex.: {"name": 1})
+	Projection *QueryProjections `json:"projection,omitempty"`
+
+	// the sort query parameter (ex.: "city,-lastname")
+	Sort *QuerySort `json:"sort,omitempty"`
+
+	// the pages query parameter
+	Page *QueryPage `json:"page,omitempty"`
+
+	// the max results query parameter
+	MaxResults *QueryMaxResults `json:"max_results,omitempty"`
+}
+
+// DeleteBackupinstanceItemParams defines parameters for DeleteBackupinstanceItem.
+type DeleteBackupinstanceItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PatchBackupinstanceItemParams defines parameters for PatchBackupinstanceItem.
+type PatchBackupinstanceItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PutBackupinstanceItemParams defines parameters for PutBackupinstanceItem.
+type PutBackupinstanceItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
 // Getv1internalaclsParams defines parameters for Getv1internalacls.
 type Getv1internalaclsParams struct {
 	// the filters query parameter (ex.: {"number": 10})
@@ -275,6 +877,114 @@ type PutInternalaclItemParams struct {
 	IfMatch IfMatch `json:"If-Match"`
 }
 
+// Getv1jobsParams defines parameters for Getv1jobs.
+type Getv1jobsParams struct {
+	// the filters query parameter (ex.: {"number": 10})
+	Where *QueryWhere `json:"where,omitempty"`
+
+	// the projections query parameter (ex.: {"name": 1})
+	Projection *QueryProjections `json:"projection,omitempty"`
+
+	// the sort query parameter (ex.: "city,-lastname")
+	Sort *QuerySort `json:"sort,omitempty"`
+
+	// the pages query parameter
+	Page *QueryPage `json:"page,omitempty"`
+
+	// the max results query parameter
+	MaxResults *QueryMaxResults `json:"max_results,omitempty"`
+}
+
+// DeleteJobItemParams defines parameters for DeleteJobItem.
+type DeleteJobItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PatchJobItemParams defines parameters for PatchJobItem.
+type PatchJobItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PutJobItemParams defines parameters for PutJobItem.
+type PutJobItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// Getv1kubebackupsParams defines parameters for Getv1kubebackups.
+type Getv1kubebackupsParams struct {
+	// the filters query parameter (ex.: {"number": 10})
+	Where *QueryWhere `json:"where,omitempty"`
+
+	// the projections query parameter (ex.: {"name": 1})
+	Projection *QueryProjections `json:"projection,omitempty"`
+
+	// the sort query parameter (ex.: "city,-lastname")
+	Sort *QuerySort `json:"sort,omitempty"`
+
+	// the pages query parameter
+	Page *QueryPage `json:"page,omitempty"`
+
+	// the max results query parameter
+	MaxResults *QueryMaxResults `json:"max_results,omitempty"`
+}
+
+// DeleteKubebackupItemParams defines parameters for DeleteKubebackupItem.
+type DeleteKubebackupItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PatchKubebackupItemParams defines parameters for PatchKubebackupItem.
+type PatchKubebackupItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PutKubebackupItemParams defines parameters for PutKubebackupItem.
+type PutKubebackupItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// Getv1kubeclustersParams defines parameters for Getv1kubeclusters.
+type Getv1kubeclustersParams struct {
+	// the filters query parameter (ex.: {"number": 10})
+	Where *QueryWhere `json:"where,omitempty"`
+
+	// the projections query parameter (ex.: {"name": 1})
+	Projection *QueryProjections `json:"projection,omitempty"`
+
+	// the sort query parameter (ex.: "city,-lastname")
+	Sort *QuerySort `json:"sort,omitempty"`
+
+	// the pages query parameter
+	Page *QueryPage `json:"page,omitempty"`
+
+	// the max results query parameter
+	MaxResults *QueryMaxResults `json:"max_results,omitempty"`
+}
+
+// DeleteKubeclusterItemParams defines parameters for DeleteKubeclusterItem.
+type DeleteKubeclusterItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PatchKubeclusterItemParams defines parameters for PatchKubeclusterItem.
+type PatchKubeclusterItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PutKubeclusterItemParams defines parameters for PutKubeclusterItem.
+type PutKubeclusterItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
 // Getv1kubenamespacesParams defines parameters for Getv1kubenamespaces.
 type Getv1kubenamespacesParams struct {
 	// the filters query parameter (ex.: {"number": 10})
@@ -311,6 +1021,42 @@ type PutKubenamespaceItemParams struct {
 	IfMatch IfMatch `json:"If-Match"`
 }
 
+// Getv1kuberestoresParams defines parameters for Getv1kuberestores.
+type Getv1kuberestoresParams struct {
+	// the filters query parameter (ex.: {"number": 10})
+	Where *QueryWhere `json:"where,omitempty"`
+
+	// the projections query parameter (ex.: {"name": 1})
+	Projection *QueryProjections `json:"projection,omitempty"`
+
+	// the sort query parameter (ex.: "city,-lastname")
+	Sort *QuerySort `json:"sort,omitempty"`
+
+	// the pages query parameter
+	Page *QueryPage `json:"page,omitempty"`
+
+	// the max results query parameter
+	MaxResults *QueryMaxResults `json:"max_results,omitempty"`
+}
+
+// DeleteKuberestoreItemParams defines parameters for DeleteKuberestoreItem.
+type DeleteKuberestoreItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PatchKuberestoreItemParams defines parameters for PatchKuberestoreItem.
+type PatchKuberestoreItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PutKuberestoreItemParams defines parameters for PutKuberestoreItem.
+type PutKuberestoreItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
 // Getv1orginvitesParams defines parameters for Getv1orginvites.
 type Getv1orginvitesParams struct {
 	// the filters query parameter (ex.: {"number": 10})
@@ -377,8 +1123,8 @@ type PutOrgItemParams struct {
 	IfMatch IfMatch `json:"If-Match"`
 }
 
-// Getv1usergroupsParams defines parameters for Getv1usergroups.
-type Getv1usergroupsParams struct {
+// Getv1policiesParams defines parameters for Getv1policies.
+type Getv1policiesParams struct {
 	// the filters query parameter (ex.: {"number": 10})
 	Where *QueryWhere `json:"where,omitempty"`
 
@@ -395,32 +1141,26 @@ type Getv1usergroupsParams struct {
 	MaxResults *QueryMaxResults `json:"max_results,omitempty"`
 }
 
-// DeleteUsergroupItemParams defines parameters for DeleteUsergroupItem.
-type DeleteUsergroupItemParams struct {
-	// Current value of the _etag field
-	IfMatch IfMatch `json:"If-Match"`
-}
-
-// PatchUsergroupItemParams defines parameters for PatchUsergroupItem.
-type PatchUsergroupItemParams struct {
+// DeletepolicyItemParams defines parameters for DeletepolicyItem.
+type DeletepolicyItemParams struct {
 	// Current value of the _etag field
 	IfMatch IfMatch `json:"If-Match"`
 }
 
-// PutUsergroupItemParams defines parameters for PutUsergroupItem.
-type PutUsergroupItemParams struct {
+// PatchpolicyItemParams defines parameters for PatchpolicyItem.
+type PatchpolicyItemParams struct {
 	// Current value of the _etag field
 	IfMatch IfMatch `json:"If-Match"`
 }
 
-// UpdateUserGroupACLParams defines parameters for UpdateUserGroupACL.
-type UpdateUserGroupACLParams struct {
+// PutpolicyItemParams defines parameters for PutpolicyItem.
+type PutpolicyItemParams struct {
 	// Current value of the _etag field
 	IfMatch IfMatch `json:"If-Match"`
 }
 
-// Getv1usersParams defines parameters for Getv1users.
-type Getv1usersParams struct {
+// Getv1runkubebackupParams defines parameters for Getv1runkubebackup.
+type Getv1runkubebackupParams struct {
 	// the filters query parameter (ex.: {"number": 10})
 	Where *QueryWhere `json:"where,omitempty"`
 
@@ -437,20 +1177,107 @@ type Getv1usersParams struct {
 	MaxResults *QueryMaxResults `json:"max_results,omitempty"`
 }
 
-// PatchUserItemParams defines parameters for PatchUserItem.
-type PatchUserItemParams struct {
+// DeleteRunkubebackupItemParams defines parameters for DeleteRunkubebackupItem.
+type DeleteRunkubebackupItemParams struct {
 	// Current value of the _etag field
 	IfMatch IfMatch `json:"If-Match"`
 }
 
-// PutUserItemParams defines parameters for PutUserItem.
-type PutUserItemParams struct {
+// PatchRunkubebackupItemParams defines parameters for PatchRunkubebackupItem.
+type PatchRunkubebackupItemParams struct {
 	// Current value of the _etag field
 	IfMatch IfMatch `json:"If-Match"`
 }
 
-// Postv1internalaclsJSONRequestBody defines body for Postv1internalacls for application/json ContentType.
-type Postv1internalaclsJSONRequestBody Internalacl
+// PutRunkubebackupItemParams defines parameters for PutRunkubebackupItem.
+type PutRunkubebackupItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// Getv1usergroupsParams defines parameters for Getv1usergroups.
+type Getv1usergroupsParams struct {
+	// the filters query parameter (ex.: {"number": 10})
+	Where *QueryWhere `json:"where,omitempty"`
+
+	// the projections query parameter (ex.: {"name": 1})
+	Projection *QueryProjections `json:"projection,omitempty"`
+
+	// the sort query parameter (ex.: "city,-lastname")
+	Sort *QuerySort `json:"sort,omitempty"`
+
+	// the pages query parameter
+	Page *QueryPage `json:"page,omitempty"`
+
+	// the max results query parameter
+	MaxResults *QueryMaxResults `json:"max_results,omitempty"`
+}
+
+// DeleteUsergroupItemParams defines parameters for DeleteUsergroupItem.
+type DeleteUsergroupItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PatchUsergroupItemParams defines parameters for PatchUsergroupItem.
+type PatchUsergroupItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PutUsergroupItemParams defines parameters for PutUsergroupItem.
+type PutUsergroupItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// UpdateUserGroupACLParams defines parameters for UpdateUserGroupACL.
+type UpdateUserGroupACLParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// Getv1usersParams defines parameters for Getv1users.
+type Getv1usersParams struct {
+	// the filters query parameter (ex.: {"number": 10})
+	Where *QueryWhere `json:"where,omitempty"`
+
+	// the projections query parameter (ex.: {"name": 1})
+	Projection *QueryProjections `json:"projection,omitempty"`
+
+	// the sort query parameter (ex.: "city,-lastname")
+	Sort *QuerySort `json:"sort,omitempty"`
+
+	// the pages query parameter
+	Page *QueryPage `json:"page,omitempty"`
+
+	// the max results query parameter
+	MaxResults *QueryMaxResults `json:"max_results,omitempty"`
+}
+
+// PatchUserItemParams defines parameters for PatchUserItem.
+type PatchUserItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// PutUserItemParams defines parameters for PutUserItem.
+type PutUserItemParams struct {
+	// Current value of the _etag field
+	IfMatch IfMatch `json:"If-Match"`
+}
+
+// Postv1backupinstancesJSONRequestBody defines body for Postv1backupinstances for application/json ContentType.
+type Postv1backupinstancesJSONRequestBody Backupinstance
+
+// PatchBackupinstanceItemJSONRequestBody defines body for PatchBackupinstanceItem for application/json ContentType.
+type PatchBackupinstanceItemJSONRequestBody Backupinstance
+
+// PutBackupinstanceItemJSONRequestBody defines body for PutBackupinstanceItem for application/json ContentType.
+type PutBackupinstanceItemJSONRequestBody Backupinstance
+
+// Postv1internalaclsJSONRequestBody defines body for Postv1internalacls for application/json ContentType.
+type Postv1internalaclsJSONRequestBody Internalacl
 
 // PatchInternalaclItemJSONRequestBody defines body for PatchInternalaclItem for application/json ContentType.
 type PatchInternalaclItemJSONRequestBody Internalacl
@@ -458,6 +1285,33 @@ type PatchInternalaclItemJSONRequestBody Internalacl
 // PutInternalaclItemJSONRequestBody defines body for PutInternalaclItem for application/json ContentType.
 type PutInternalaclItemJSONRequestBody Internalacl
 
+// Postv1jobsJSONRequestBody defines body for Postv1jobs for application/json ContentType.
+type Postv1jobsJSONRequestBody Job
+
+// PatchJobItemJSONRequestBody defines body for PatchJobItem for application/json ContentType.
+type PatchJobItemJSONRequestBody Job
+
+// PutJobItemJSONRequestBody defines body for PutJobItem for application/json ContentType.
+type PutJobItemJSONRequestBody Job
+
+// Postv1kubebackupsJSONRequestBody defines body for Postv1kubebackups for application/json ContentType.
+type Postv1kubebackupsJSONRequestBody Kubebackup
+
+// PatchKubebackupItemJSONRequestBody defines body for PatchKubebackupItem for application/json ContentType.
+type PatchKubebackupItemJSONRequestBody Kubebackup
+
+// PutKubebackupItemJSONRequestBody defines body for PutKubebackupItem for application/json ContentType.
+type PutKubebackupItemJSONRequestBody Kubebackup
+
+// Postv1kubeclustersJSONRequestBody defines body for Postv1kubeclusters for application/json ContentType.
+type Postv1kubeclustersJSONRequestBody Kubecluster
+
+// PatchKubeclusterItemJSONRequestBody defines body for PatchKubeclusterItem for application/json ContentType.
+type PatchKubeclusterItemJSONRequestBody Kubecluster
+
+// PutKubeclusterItemJSONRequestBody defines body for PutKubeclusterItem for application/json ContentType.
+type PutKubeclusterItemJSONRequestBody Kubecluster
+
 // Postv1kubenamespacesJSONRequestBody defines body for Postv1kubenamespaces for application/json ContentType.
 type Postv1kubenamespacesJSONRequestBody Kubenamespace
 
@@ -467,6 +1321,15 @@ type PatchKubenamespaceItemJSONRequestBody Kubenamespace
 // PutKubenamespaceItemJSONRequestBody defines body for PutKubenamespaceItem for application/json ContentType.
 type PutKubenamespaceItemJSONRequestBody Kubenamespace
 
+// Postv1kuberestoresJSONRequestBody defines body for Postv1kuberestores for application/json ContentType.
+type Postv1kuberestoresJSONRequestBody Kuberestore
+
+// PatchKuberestoreItemJSONRequestBody defines body for PatchKuberestoreItem for application/json ContentType.
+type PatchKuberestoreItemJSONRequestBody Kuberestore
+
+// PutKuberestoreItemJSONRequestBody defines body for PutKuberestoreItem for application/json ContentType.
+type PutKuberestoreItemJSONRequestBody Kuberestore
+
 // Postv1orginvitesJSONRequestBody defines body for Postv1orginvites for application/json ContentType.
 type Postv1orginvitesJSONRequestBody Orginvite
 
@@ -482,6 +1345,24 @@ type PatchOrgItemJSONRequestBody Org
 // PutOrgItemJSONRequestBody defines body for PutOrgItem for application/json ContentType.
 type PutOrgItemJSONRequestBody Org
 
+// Postv1policiesJSONRequestBody defines body for Postv1policies for application/json ContentType.
+type Postv1policiesJSONRequestBody Policy
+
+// PatchpolicyItemJSONRequestBody defines body for PatchpolicyItem for application/json ContentType.
+type PatchpolicyItemJSONRequestBody Policy
+
+// PutpolicyItemJSONRequestBody defines body for PutpolicyItem for application/json ContentType.
+type PutpolicyItemJSONRequestBody Policy
+
+// Postv1runkubebackupJSONRequestBody defines body for Postv1runkubebackup for application/json ContentType.
+type Postv1runkubebackupJSONRequestBody Runkubebackup
+
+// PatchRunkubebackupItemJSONRequestBody defines body for PatchRunkubebackupItem for application/json ContentType.
+type PatchRunkubebackupItemJSONRequestBody Runkubebackup
+
+// PutRunkubebackupItemJSONRequestBody defines body for PutRunkubebackupItem for application/json ContentType.
+type PutRunkubebackupItemJSONRequestBody Runkubebackup
+
 // Postv1usergroupsJSONRequestBody defines body for Postv1usergroups for application/json ContentType.
 type Postv1usergroupsJSONRequestBody Usergroup
 
@@ -573,6 +1454,30 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// Getv1backupinstances request
+	Getv1backupinstances(ctx context.Context, params *Getv1backupinstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Postv1backupinstances request with any body
+	Postv1backupinstancesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Postv1backupinstances(ctx context.Context, body Postv1backupinstancesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBackupinstanceItem request
+	DeleteBackupinstanceItem(ctx context.Context, backupinstanceId BackupinstanceId, params *DeleteBackupinstanceItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBackupinstanceItem request
+	GetBackupinstanceItem(ctx context.Context, backupinstanceId BackupinstanceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchBackupinstanceItem request with any body
+	PatchBackupinstanceItemWithBody(ctx context.Context, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchBackupinstanceItem(ctx context.Context, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, body PatchBackupinstanceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBackupinstanceItem request with any body
+	PutBackupinstanceItemWithBody(ctx context.Context, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutBackupinstanceItem(ctx context.Context, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, body PutBackupinstanceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Getv1internalacls request
 	Getv1internalacls(ctx context.Context, params *Getv1internalaclsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -597,6 +1502,81 @@ type ClientInterface interface {
 
 	PutInternalaclItem(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, body PutInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Deletev1jobs request
+	Deletev1jobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Getv1jobs request
+	Getv1jobs(ctx context.Context, params *Getv1jobsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Postv1jobs request with any body
+	Postv1jobsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Postv1jobs(ctx context.Context, body Postv1jobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteJobItem request
+	DeleteJobItem(ctx context.Context, jobId JobId, params *DeleteJobItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJobItem request
+	GetJobItem(ctx context.Context, jobId JobId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchJobItem request with any body
+	PatchJobItemWithBody(ctx context.Context, jobId JobId, params *PatchJobItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchJobItem(ctx context.Context, jobId JobId, params *PatchJobItemParams, body PatchJobItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutJobItem request with any body
+	PutJobItemWithBody(ctx context.Context, jobId JobId, params *PutJobItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutJobItem(ctx context.Context, jobId JobId, params *PutJobItemParams, body PutJobItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Getv1kubebackups request
+	Getv1kubebackups(ctx context.Context, params *Getv1kubebackupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Postv1kubebackups request with any body
+	Postv1kubebackupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Postv1kubebackups(ctx context.Context, body Postv1kubebackupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteKubebackupItem request
+	DeleteKubebackupItem(ctx context.Context, kubebackupId KubebackupId, params *DeleteKubebackupItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetKubebackupItem request
+	GetKubebackupItem(ctx context.Context, kubebackupId KubebackupId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchKubebackupItem request with any body
+	PatchKubebackupItemWithBody(ctx context.Context, kubebackupId KubebackupId, params *PatchKubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchKubebackupItem(ctx context.Context, kubebackupId KubebackupId, params *PatchKubebackupItemParams, body PatchKubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutKubebackupItem request with any body
+	PutKubebackupItemWithBody(ctx context.Context, kubebackupId KubebackupId, params *PutKubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutKubebackupItem(ctx context.Context, kubebackupId KubebackupId, params *PutKubebackupItemParams, body PutKubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Getv1kubeclusters request
+	Getv1kubeclusters(ctx context.Context, params *Getv1kubeclustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Postv1kubeclusters request with any body
+	Postv1kubeclustersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Postv1kubeclusters(ctx context.Context, body Postv1kubeclustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteKubeclusterItem request
+	DeleteKubeclusterItem(ctx context.Context, kubeclusterId KubeclusterId, params *DeleteKubeclusterItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetKubeclusterItem request
+	GetKubeclusterItem(ctx context.Context, kubeclusterId KubeclusterId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchKubeclusterItem request with any body
+	PatchKubeclusterItemWithBody(ctx context.Context, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchKubeclusterItem(ctx context.Context, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, body PatchKubeclusterItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutKubeclusterItem request with any body
+	PutKubeclusterItemWithBody(ctx context.Context, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutKubeclusterItem(ctx context.Context, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, body PutKubeclusterItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Deletev1kubenamespaces request
 	Deletev1kubenamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -624,6 +1604,30 @@ type ClientInterface interface {
 
 	PutKubenamespaceItem(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, body PutKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Getv1kuberestores request
+	Getv1kuberestores(ctx context.Context, params *Getv1kuberestoresParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Postv1kuberestores request with any body
+	Postv1kuberestoresWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Postv1kuberestores(ctx context.Context, body Postv1kuberestoresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteKuberestoreItem request
+	DeleteKuberestoreItem(ctx context.Context, kuberestoreId KuberestoreId, params *DeleteKuberestoreItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetKuberestoreItem request
+	GetKuberestoreItem(ctx context.Context, kuberestoreId KuberestoreId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchKuberestoreItem request with any body
+	PatchKuberestoreItemWithBody(ctx context.Context, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchKuberestoreItem(ctx context.Context, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, body PatchKuberestoreItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutKuberestoreItem request with any body
+	PutKuberestoreItemWithBody(ctx context.Context, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutKuberestoreItem(ctx context.Context, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, body PutKuberestoreItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Deletev1orginvites request
 	Deletev1orginvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -667,6 +1671,54 @@ type ClientInterface interface {
 
 	PutOrgItem(ctx context.Context, orgId OrgId, params *PutOrgItemParams, body PutOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Getv1policies request
+	Getv1policies(ctx context.Context, params *Getv1policiesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Postv1policies request with any body
+	Postv1policiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Postv1policies(ctx context.Context, body Postv1policiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletepolicyItem request
+	DeletepolicyItem(ctx context.Context, policyId PolicyId, params *DeletepolicyItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetpolicyItem request
+	GetpolicyItem(ctx context.Context, policyId PolicyId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchpolicyItem request with any body
+	PatchpolicyItemWithBody(ctx context.Context, policyId PolicyId, params *PatchpolicyItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchpolicyItem(ctx context.Context, policyId PolicyId, params *PatchpolicyItemParams, body PatchpolicyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutpolicyItem request with any body
+	PutpolicyItemWithBody(ctx context.Context, policyId PolicyId, params *PutpolicyItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutpolicyItem(ctx context.Context, policyId PolicyId, params *PutpolicyItemParams, body PutpolicyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Getv1runkubebackup request
+	Getv1runkubebackup(ctx context.Context, params *Getv1runkubebackupParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Postv1runkubebackup request with any body
+	Postv1runkubebackupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Postv1runkubebackup(ctx context.Context, body Postv1runkubebackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRunkubebackupItem request
+	DeleteRunkubebackupItem(ctx context.Context, runkubebackupId RunkubebackupId, params *DeleteRunkubebackupItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRunkubebackupItem request
+	GetRunkubebackupItem(ctx context.Context, runkubebackupId RunkubebackupId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchRunkubebackupItem request with any body
+	PatchRunkubebackupItemWithBody(ctx context.Context, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchRunkubebackupItem(ctx context.Context, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, body PatchRunkubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutRunkubebackupItem request with any body
+	PutRunkubebackupItemWithBody(ctx context.Context, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutRunkubebackupItem(ctx context.Context, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, body PutRunkubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Getv1usergroups request
 	Getv1usergroups(ctx context.Context, params *Getv1usergroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -713,8 +1765,8 @@ type ClientInterface interface {
 	PutUserItem(ctx context.Context, userId UserId, params *PutUserItemParams, body PutUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) Getv1internalacls(ctx context.Context, params *Getv1internalaclsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetv1internalaclsRequest(c.Server, params)
+func (c *Client) Getv1backupinstances(ctx context.Context, params *Getv1backupinstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1backupinstancesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -725,8 +1777,8 @@ func (c *Client) Getv1internalacls(ctx context.Context, params *Getv1internalacl
 	return c.Client.Do(req)
 }
 
-func (c *Client) Postv1internalaclsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostv1internalaclsRequestWithBody(c.Server, contentType, body)
+func (c *Client) Postv1backupinstancesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1backupinstancesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -737,8 +1789,8 @@ func (c *Client) Postv1internalaclsWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) Postv1internalacls(ctx context.Context, body Postv1internalaclsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostv1internalaclsRequest(c.Server, body)
+func (c *Client) Postv1backupinstances(ctx context.Context, body Postv1backupinstancesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1backupinstancesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -749,8 +1801,8 @@ func (c *Client) Postv1internalacls(ctx context.Context, body Postv1internalacls
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteInternalaclItem(ctx context.Context, internalaclId InternalaclId, params *DeleteInternalaclItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteInternalaclItemRequest(c.Server, internalaclId, params)
+func (c *Client) DeleteBackupinstanceItem(ctx context.Context, backupinstanceId BackupinstanceId, params *DeleteBackupinstanceItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBackupinstanceItemRequest(c.Server, backupinstanceId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -761,8 +1813,8 @@ func (c *Client) DeleteInternalaclItem(ctx context.Context, internalaclId Intern
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetInternalaclItem(ctx context.Context, internalaclId InternalaclId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetInternalaclItemRequest(c.Server, internalaclId)
+func (c *Client) GetBackupinstanceItem(ctx context.Context, backupinstanceId BackupinstanceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBackupinstanceItemRequest(c.Server, backupinstanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -773,8 +1825,8 @@ func (c *Client) GetInternalaclItem(ctx context.Context, internalaclId Internala
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchInternalaclItemWithBody(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchInternalaclItemRequestWithBody(c.Server, internalaclId, params, contentType, body)
+func (c *Client) PatchBackupinstanceItemWithBody(ctx context.Context, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchBackupinstanceItemRequestWithBody(c.Server, backupinstanceId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -785,8 +1837,8 @@ func (c *Client) PatchInternalaclItemWithBody(ctx context.Context, internalaclId
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchInternalaclItem(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, body PatchInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchInternalaclItemRequest(c.Server, internalaclId, params, body)
+func (c *Client) PatchBackupinstanceItem(ctx context.Context, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, body PatchBackupinstanceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchBackupinstanceItemRequest(c.Server, backupinstanceId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -797,8 +1849,8 @@ func (c *Client) PatchInternalaclItem(ctx context.Context, internalaclId Interna
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutInternalaclItemWithBody(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutInternalaclItemRequestWithBody(c.Server, internalaclId, params, contentType, body)
+func (c *Client) PutBackupinstanceItemWithBody(ctx context.Context, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBackupinstanceItemRequestWithBody(c.Server, backupinstanceId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -809,8 +1861,8 @@ func (c *Client) PutInternalaclItemWithBody(ctx context.Context, internalaclId I
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutInternalaclItem(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, body PutInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutInternalaclItemRequest(c.Server, internalaclId, params, body)
+func (c *Client) PutBackupinstanceItem(ctx context.Context, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, body PutBackupinstanceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBackupinstanceItemRequest(c.Server, backupinstanceId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -821,8 +1873,8 @@ func (c *Client) PutInternalaclItem(ctx context.Context, internalaclId Internala
 	return c.Client.Do(req)
 }
 
-func (c *Client) Deletev1kubenamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletev1kubenamespacesRequest(c.Server)
+func (c *Client) Getv1internalacls(ctx context.Context, params *Getv1internalaclsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1internalaclsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -833,8 +1885,8 @@ func (c *Client) Deletev1kubenamespaces(ctx context.Context, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) Getv1kubenamespaces(ctx context.Context, params *Getv1kubenamespacesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetv1kubenamespacesRequest(c.Server, params)
+func (c *Client) Postv1internalaclsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1internalaclsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -845,8 +1897,8 @@ func (c *Client) Getv1kubenamespaces(ctx context.Context, params *Getv1kubenames
 	return c.Client.Do(req)
 }
 
-func (c *Client) Postv1kubenamespacesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostv1kubenamespacesRequestWithBody(c.Server, contentType, body)
+func (c *Client) Postv1internalacls(ctx context.Context, body Postv1internalaclsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1internalaclsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -857,8 +1909,8 @@ func (c *Client) Postv1kubenamespacesWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) Postv1kubenamespaces(ctx context.Context, body Postv1kubenamespacesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostv1kubenamespacesRequest(c.Server, body)
+func (c *Client) DeleteInternalaclItem(ctx context.Context, internalaclId InternalaclId, params *DeleteInternalaclItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInternalaclItemRequest(c.Server, internalaclId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -869,8 +1921,8 @@ func (c *Client) Postv1kubenamespaces(ctx context.Context, body Postv1kubenamesp
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteKubenamespaceItem(ctx context.Context, kubenamespaceId KubenamespaceId, params *DeleteKubenamespaceItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteKubenamespaceItemRequest(c.Server, kubenamespaceId, params)
+func (c *Client) GetInternalaclItem(ctx context.Context, internalaclId InternalaclId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInternalaclItemRequest(c.Server, internalaclId)
 	if err != nil {
 		return nil, err
 	}
@@ -881,8 +1933,8 @@ func (c *Client) DeleteKubenamespaceItem(ctx context.Context, kubenamespaceId Ku
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetKubenamespaceItem(ctx context.Context, kubenamespaceId KubenamespaceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetKubenamespaceItemRequest(c.Server, kubenamespaceId)
+func (c *Client) PatchInternalaclItemWithBody(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchInternalaclItemRequestWithBody(c.Server, internalaclId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -893,8 +1945,8 @@ func (c *Client) GetKubenamespaceItem(ctx context.Context, kubenamespaceId Kuben
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchKubenamespaceItemWithBody(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchKubenamespaceItemRequestWithBody(c.Server, kubenamespaceId, params, contentType, body)
+func (c *Client) PatchInternalaclItem(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, body PatchInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchInternalaclItemRequest(c.Server, internalaclId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -905,8 +1957,8 @@ func (c *Client) PatchKubenamespaceItemWithBody(ctx context.Context, kubenamespa
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchKubenamespaceItem(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, body PatchKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchKubenamespaceItemRequest(c.Server, kubenamespaceId, params, body)
+func (c *Client) PutInternalaclItemWithBody(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutInternalaclItemRequestWithBody(c.Server, internalaclId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -917,8 +1969,8 @@ func (c *Client) PatchKubenamespaceItem(ctx context.Context, kubenamespaceId Kub
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutKubenamespaceItemWithBody(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutKubenamespaceItemRequestWithBody(c.Server, kubenamespaceId, params, contentType, body)
+func (c *Client) PutInternalaclItem(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, body PutInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutInternalaclItemRequest(c.Server, internalaclId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -929,8 +1981,8 @@ func (c *Client) PutKubenamespaceItemWithBody(ctx context.Context, kubenamespace
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutKubenamespaceItem(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, body PutKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutKubenamespaceItemRequest(c.Server, kubenamespaceId, params, body)
+func (c *Client) Deletev1jobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletev1jobsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -941,8 +1993,8 @@ func (c *Client) PutKubenamespaceItem(ctx context.Context, kubenamespaceId Kuben
 	return c.Client.Do(req)
 }
 
-func (c *Client) Deletev1orginvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletev1orginvitesRequest(c.Server)
+func (c *Client) Getv1jobs(ctx context.Context, params *Getv1jobsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1jobsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -953,8 +2005,8 @@ func (c *Client) Deletev1orginvites(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) Getv1orginvites(ctx context.Context, params *Getv1orginvitesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetv1orginvitesRequest(c.Server, params)
+func (c *Client) Postv1jobsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1jobsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -965,8 +2017,8 @@ func (c *Client) Getv1orginvites(ctx context.Context, params *Getv1orginvitesPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) Postv1orginvitesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostv1orginvitesRequestWithBody(c.Server, contentType, body)
+func (c *Client) Postv1jobs(ctx context.Context, body Postv1jobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1jobsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -977,8 +2029,8 @@ func (c *Client) Postv1orginvitesWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) Postv1orginvites(ctx context.Context, body Postv1orginvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostv1orginvitesRequest(c.Server, body)
+func (c *Client) DeleteJobItem(ctx context.Context, jobId JobId, params *DeleteJobItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteJobItemRequest(c.Server, jobId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -989,8 +2041,8 @@ func (c *Client) Postv1orginvites(ctx context.Context, body Postv1orginvitesJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteOrginviteItem(ctx context.Context, orginviteId OrginviteId, params *DeleteOrginviteItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteOrginviteItemRequest(c.Server, orginviteId, params)
+func (c *Client) GetJobItem(ctx context.Context, jobId JobId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobItemRequest(c.Server, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1001,8 +2053,8 @@ func (c *Client) DeleteOrginviteItem(ctx context.Context, orginviteId OrginviteI
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrginviteItem(ctx context.Context, orginviteId OrginviteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrginviteItemRequest(c.Server, orginviteId)
+func (c *Client) PatchJobItemWithBody(ctx context.Context, jobId JobId, params *PatchJobItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchJobItemRequestWithBody(c.Server, jobId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1013,8 +2065,8 @@ func (c *Client) GetOrginviteItem(ctx context.Context, orginviteId OrginviteId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchOrginviteItemWithBody(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchOrginviteItemRequestWithBody(c.Server, orginviteId, params, contentType, body)
+func (c *Client) PatchJobItem(ctx context.Context, jobId JobId, params *PatchJobItemParams, body PatchJobItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchJobItemRequest(c.Server, jobId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1025,8 +2077,8 @@ func (c *Client) PatchOrginviteItemWithBody(ctx context.Context, orginviteId Org
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchOrginviteItem(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, body PatchOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchOrginviteItemRequest(c.Server, orginviteId, params, body)
+func (c *Client) PutJobItemWithBody(ctx context.Context, jobId JobId, params *PutJobItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutJobItemRequestWithBody(c.Server, jobId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1037,8 +2089,8 @@ func (c *Client) PatchOrginviteItem(ctx context.Context, orginviteId OrginviteId
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutOrginviteItemWithBody(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutOrginviteItemRequestWithBody(c.Server, orginviteId, params, contentType, body)
+func (c *Client) PutJobItem(ctx context.Context, jobId JobId, params *PutJobItemParams, body PutJobItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutJobItemRequest(c.Server, jobId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1049,8 +2101,8 @@ func (c *Client) PutOrginviteItemWithBody(ctx context.Context, orginviteId Orgin
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutOrginviteItem(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, body PutOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutOrginviteItemRequest(c.Server, orginviteId, params, body)
+func (c *Client) Getv1kubebackups(ctx context.Context, params *Getv1kubebackupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1kubebackupsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1061,8 +2113,8 @@ func (c *Client) PutOrginviteItem(ctx context.Context, orginviteId OrginviteId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) Getv1orgs(ctx context.Context, params *Getv1orgsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetv1orgsRequest(c.Server, params)
+func (c *Client) Postv1kubebackupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1kubebackupsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1073,8 +2125,8 @@ func (c *Client) Getv1orgs(ctx context.Context, params *Getv1orgsParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOrgItem(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOrgItemRequest(c.Server, orgId)
+func (c *Client) Postv1kubebackups(ctx context.Context, body Postv1kubebackupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1kubebackupsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1085,8 +2137,8 @@ func (c *Client) GetOrgItem(ctx context.Context, orgId OrgId, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchOrgItemWithBody(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchOrgItemRequestWithBody(c.Server, orgId, params, contentType, body)
+func (c *Client) DeleteKubebackupItem(ctx context.Context, kubebackupId KubebackupId, params *DeleteKubebackupItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteKubebackupItemRequest(c.Server, kubebackupId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1097,8 +2149,8 @@ func (c *Client) PatchOrgItemWithBody(ctx context.Context, orgId OrgId, params *
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchOrgItem(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, body PatchOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchOrgItemRequest(c.Server, orgId, params, body)
+func (c *Client) GetKubebackupItem(ctx context.Context, kubebackupId KubebackupId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKubebackupItemRequest(c.Server, kubebackupId)
 	if err != nil {
 		return nil, err
 	}
@@ -1109,8 +2161,8 @@ func (c *Client) PatchOrgItem(ctx context.Context, orgId OrgId, params *PatchOrg
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutOrgItemWithBody(ctx context.Context, orgId OrgId, params *PutOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutOrgItemRequestWithBody(c.Server, orgId, params, contentType, body)
+func (c *Client) PatchKubebackupItemWithBody(ctx context.Context, kubebackupId KubebackupId, params *PatchKubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchKubebackupItemRequestWithBody(c.Server, kubebackupId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1121,8 +2173,8 @@ func (c *Client) PutOrgItemWithBody(ctx context.Context, orgId OrgId, params *Pu
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutOrgItem(ctx context.Context, orgId OrgId, params *PutOrgItemParams, body PutOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutOrgItemRequest(c.Server, orgId, params, body)
+func (c *Client) PatchKubebackupItem(ctx context.Context, kubebackupId KubebackupId, params *PatchKubebackupItemParams, body PatchKubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchKubebackupItemRequest(c.Server, kubebackupId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1133,8 +2185,8 @@ func (c *Client) PutOrgItem(ctx context.Context, orgId OrgId, params *PutOrgItem
 	return c.Client.Do(req)
 }
 
-func (c *Client) Getv1usergroups(ctx context.Context, params *Getv1usergroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetv1usergroupsRequest(c.Server, params)
+func (c *Client) PutKubebackupItemWithBody(ctx context.Context, kubebackupId KubebackupId, params *PutKubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutKubebackupItemRequestWithBody(c.Server, kubebackupId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1145,8 +2197,8 @@ func (c *Client) Getv1usergroups(ctx context.Context, params *Getv1usergroupsPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) Postv1usergroupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostv1usergroupsRequestWithBody(c.Server, contentType, body)
+func (c *Client) PutKubebackupItem(ctx context.Context, kubebackupId KubebackupId, params *PutKubebackupItemParams, body PutKubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutKubebackupItemRequest(c.Server, kubebackupId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1157,8 +2209,8 @@ func (c *Client) Postv1usergroupsWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) Postv1usergroups(ctx context.Context, body Postv1usergroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostv1usergroupsRequest(c.Server, body)
+func (c *Client) Getv1kubeclusters(ctx context.Context, params *Getv1kubeclustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1kubeclustersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1169,8 +2221,8 @@ func (c *Client) Postv1usergroups(ctx context.Context, body Postv1usergroupsJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteUsergroupItem(ctx context.Context, usergroupId UsergroupId, params *DeleteUsergroupItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUsergroupItemRequest(c.Server, usergroupId, params)
+func (c *Client) Postv1kubeclustersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1kubeclustersRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1181,8 +2233,8 @@ func (c *Client) DeleteUsergroupItem(ctx context.Context, usergroupId UsergroupI
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsergroupItem(ctx context.Context, usergroupId UsergroupId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsergroupItemRequest(c.Server, usergroupId)
+func (c *Client) Postv1kubeclusters(ctx context.Context, body Postv1kubeclustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1kubeclustersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1193,8 +2245,8 @@ func (c *Client) GetUsergroupItem(ctx context.Context, usergroupId UsergroupId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchUsergroupItemWithBody(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchUsergroupItemRequestWithBody(c.Server, usergroupId, params, contentType, body)
+func (c *Client) DeleteKubeclusterItem(ctx context.Context, kubeclusterId KubeclusterId, params *DeleteKubeclusterItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteKubeclusterItemRequest(c.Server, kubeclusterId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1205,8 +2257,8 @@ func (c *Client) PatchUsergroupItemWithBody(ctx context.Context, usergroupId Use
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchUsergroupItem(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, body PatchUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchUsergroupItemRequest(c.Server, usergroupId, params, body)
+func (c *Client) GetKubeclusterItem(ctx context.Context, kubeclusterId KubeclusterId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKubeclusterItemRequest(c.Server, kubeclusterId)
 	if err != nil {
 		return nil, err
 	}
@@ -1217,8 +2269,8 @@ func (c *Client) PatchUsergroupItem(ctx context.Context, usergroupId UsergroupId
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutUsergroupItemWithBody(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutUsergroupItemRequestWithBody(c.Server, usergroupId, params, contentType, body)
+func (c *Client) PatchKubeclusterItemWithBody(ctx context.Context, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchKubeclusterItemRequestWithBody(c.Server, kubeclusterId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1229,8 +2281,8 @@ func (c *Client) PutUsergroupItemWithBody(ctx context.Context, usergroupId Userg
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutUsergroupItem(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, body PutUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutUsergroupItemRequest(c.Server, usergroupId, params, body)
+func (c *Client) PatchKubeclusterItem(ctx context.Context, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, body PatchKubeclusterItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchKubeclusterItemRequest(c.Server, kubeclusterId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1241,8 +2293,8 @@ func (c *Client) PutUsergroupItem(ctx context.Context, usergroupId UsergroupId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUserGroupACLWithBody(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserGroupACLRequestWithBody(c.Server, usergroupId, params, contentType, body)
+func (c *Client) PutKubeclusterItemWithBody(ctx context.Context, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutKubeclusterItemRequestWithBody(c.Server, kubeclusterId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1253,8 +2305,8 @@ func (c *Client) UpdateUserGroupACLWithBody(ctx context.Context, usergroupId Use
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUserGroupACL(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, body UpdateUserGroupACLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserGroupACLRequest(c.Server, usergroupId, params, body)
+func (c *Client) PutKubeclusterItem(ctx context.Context, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, body PutKubeclusterItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutKubeclusterItemRequest(c.Server, kubeclusterId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1265,8 +2317,8 @@ func (c *Client) UpdateUserGroupACL(ctx context.Context, usergroupId UsergroupId
 	return c.Client.Do(req)
 }
 
-func (c *Client) Getv1users(ctx context.Context, params *Getv1usersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetv1usersRequest(c.Server, params)
+func (c *Client) Deletev1kubenamespaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletev1kubenamespacesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1277,8 +2329,8 @@ func (c *Client) Getv1users(ctx context.Context, params *Getv1usersParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserItem(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserItemRequest(c.Server, userId)
+func (c *Client) Getv1kubenamespaces(ctx context.Context, params *Getv1kubenamespacesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1kubenamespacesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1289,8 +2341,8 @@ func (c *Client) GetUserItem(ctx context.Context, userId UserId, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchUserItemWithBody(ctx context.Context, userId UserId, params *PatchUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchUserItemRequestWithBody(c.Server, userId, params, contentType, body)
+func (c *Client) Postv1kubenamespacesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1kubenamespacesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1301,8 +2353,8 @@ func (c *Client) PatchUserItemWithBody(ctx context.Context, userId UserId, param
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchUserItem(ctx context.Context, userId UserId, params *PatchUserItemParams, body PatchUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchUserItemRequest(c.Server, userId, params, body)
+func (c *Client) Postv1kubenamespaces(ctx context.Context, body Postv1kubenamespacesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1kubenamespacesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1313,8 +2365,8 @@ func (c *Client) PatchUserItem(ctx context.Context, userId UserId, params *Patch
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutUserItemWithBody(ctx context.Context, userId UserId, params *PutUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutUserItemRequestWithBody(c.Server, userId, params, contentType, body)
+func (c *Client) DeleteKubenamespaceItem(ctx context.Context, kubenamespaceId KubenamespaceId, params *DeleteKubenamespaceItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteKubenamespaceItemRequest(c.Server, kubenamespaceId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1325,8 +2377,8 @@ func (c *Client) PutUserItemWithBody(ctx context.Context, userId UserId, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutUserItem(ctx context.Context, userId UserId, params *PutUserItemParams, body PutUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutUserItemRequest(c.Server, userId, params, body)
+func (c *Client) GetKubenamespaceItem(ctx context.Context, kubenamespaceId KubenamespaceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKubenamespaceItemRequest(c.Server, kubenamespaceId)
 	if err != nil {
 		return nil, err
 	}
@@ -1337,742 +2389,776 @@ func (c *Client) PutUserItem(ctx context.Context, userId UserId, params *PutUser
 	return c.Client.Do(req)
 }
 
-// NewGetv1internalaclsRequest generates requests for Getv1internalacls
-func NewGetv1internalaclsRequest(server string, params *Getv1internalaclsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchKubenamespaceItemWithBody(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchKubenamespaceItemRequestWithBody(c.Server, kubenamespaceId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/internalacls")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchKubenamespaceItem(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, body PatchKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchKubenamespaceItemRequest(c.Server, kubenamespaceId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Where != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Projection != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) PutKubenamespaceItemWithBody(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutKubenamespaceItemRequestWithBody(c.Server, kubenamespaceId, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Sort != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Page != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) PutKubenamespaceItem(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, body PutKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutKubenamespaceItemRequest(c.Server, kubenamespaceId, params, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.MaxResults != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) Getv1kuberestores(ctx context.Context, params *Getv1kuberestoresParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1kuberestoresRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostv1internalaclsRequest calls the generic Postv1internalacls builder with application/json body
-func NewPostv1internalaclsRequest(server string, body Postv1internalaclsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) Postv1kuberestoresWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1kuberestoresRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostv1internalaclsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostv1internalaclsRequestWithBody generates requests for Postv1internalacls with any type of body
-func NewPostv1internalaclsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) Postv1kuberestores(ctx context.Context, body Postv1kuberestoresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1kuberestoresRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/internalacls")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteKuberestoreItem(ctx context.Context, kuberestoreId KuberestoreId, params *DeleteKuberestoreItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteKuberestoreItemRequest(c.Server, kuberestoreId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteInternalaclItemRequest generates requests for DeleteInternalaclItem
-func NewDeleteInternalaclItemRequest(server string, internalaclId InternalaclId, params *DeleteInternalaclItemParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "internalaclId", runtime.ParamLocationPath, internalaclId)
+func (c *Client) GetKuberestoreItem(ctx context.Context, kuberestoreId KuberestoreId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKuberestoreItemRequest(c.Server, kuberestoreId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchKuberestoreItemWithBody(ctx context.Context, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchKuberestoreItemRequestWithBody(c.Server, kuberestoreId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/internalacls/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchKuberestoreItem(ctx context.Context, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, body PatchKuberestoreItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchKuberestoreItemRequest(c.Server, kuberestoreId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	var headerParam0 string
-
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+func (c *Client) PutKuberestoreItemWithBody(ctx context.Context, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutKuberestoreItemRequestWithBody(c.Server, kuberestoreId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Set("If-Match", headerParam0)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetInternalaclItemRequest generates requests for GetInternalaclItem
-func NewGetInternalaclItemRequest(server string, internalaclId InternalaclId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "internalaclId", runtime.ParamLocationPath, internalaclId)
+func (c *Client) PutKuberestoreItem(ctx context.Context, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, body PutKuberestoreItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutKuberestoreItemRequest(c.Server, kuberestoreId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/internalacls/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) Deletev1orginvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletev1orginvitesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Getv1orginvites(ctx context.Context, params *Getv1orginvitesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1orginvitesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) Postv1orginvitesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1orginvitesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchInternalaclItemRequest calls the generic PatchInternalaclItem builder with application/json body
-func NewPatchInternalaclItemRequest(server string, internalaclId InternalaclId, params *PatchInternalaclItemParams, body PatchInternalaclItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) Postv1orginvites(ctx context.Context, body Postv1orginvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1orginvitesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchInternalaclItemRequestWithBody(server, internalaclId, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchInternalaclItemRequestWithBody generates requests for PatchInternalaclItem with any type of body
-func NewPatchInternalaclItemRequestWithBody(server string, internalaclId InternalaclId, params *PatchInternalaclItemParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "internalaclId", runtime.ParamLocationPath, internalaclId)
+func (c *Client) DeleteOrginviteItem(ctx context.Context, orginviteId OrginviteId, params *DeleteOrginviteItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrginviteItemRequest(c.Server, orginviteId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOrginviteItem(ctx context.Context, orginviteId OrginviteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrginviteItemRequest(c.Server, orginviteId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/internalacls/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchOrginviteItemWithBody(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchOrginviteItemRequestWithBody(c.Server, orginviteId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *Client) PatchOrginviteItem(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, body PatchOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchOrginviteItemRequest(c.Server, orginviteId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	var headerParam0 string
-
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+func (c *Client) PutOrginviteItemWithBody(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrginviteItemRequestWithBody(c.Server, orginviteId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Set("If-Match", headerParam0)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutInternalaclItemRequest calls the generic PutInternalaclItem builder with application/json body
-func NewPutInternalaclItemRequest(server string, internalaclId InternalaclId, params *PutInternalaclItemParams, body PutInternalaclItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutOrginviteItem(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, body PutOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrginviteItemRequest(c.Server, orginviteId, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutInternalaclItemRequestWithBody(server, internalaclId, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutInternalaclItemRequestWithBody generates requests for PutInternalaclItem with any type of body
-func NewPutInternalaclItemRequestWithBody(server string, internalaclId InternalaclId, params *PutInternalaclItemParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "internalaclId", runtime.ParamLocationPath, internalaclId)
+func (c *Client) Getv1orgs(ctx context.Context, params *Getv1orgsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1orgsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOrgItem(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgItemRequest(c.Server, orgId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/internalacls/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchOrgItemWithBody(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchOrgItemRequestWithBody(c.Server, orgId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) PatchOrgItem(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, body PatchOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchOrgItemRequest(c.Server, orgId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	var headerParam0 string
-
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+func (c *Client) PutOrgItemWithBody(ctx context.Context, orgId OrgId, params *PutOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrgItemRequestWithBody(c.Server, orgId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Set("If-Match", headerParam0)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeletev1kubenamespacesRequest generates requests for Deletev1kubenamespaces
-func NewDeletev1kubenamespacesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutOrgItem(ctx context.Context, orgId OrgId, params *PutOrgItemParams, body PutOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrgItemRequest(c.Server, orgId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/kubenamespaces")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Getv1policies(ctx context.Context, params *Getv1policiesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1policiesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) Postv1policiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1policiesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetv1kubenamespacesRequest generates requests for Getv1kubenamespaces
-func NewGetv1kubenamespacesRequest(server string, params *Getv1kubenamespacesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) Postv1policies(ctx context.Context, body Postv1policiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1policiesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/kubenamespaces")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) DeletepolicyItem(ctx context.Context, policyId PolicyId, params *DeletepolicyItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletepolicyItemRequest(c.Server, policyId, params)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetpolicyItem(ctx context.Context, policyId PolicyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetpolicyItemRequest(c.Server, policyId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryValues := queryURL.Query()
-
-	if params.Where != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Projection != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Sort != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Page != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *Client) PatchpolicyItemWithBody(ctx context.Context, policyId PolicyId, params *PatchpolicyItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchpolicyItemRequestWithBody(c.Server, policyId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
+func (c *Client) PatchpolicyItem(ctx context.Context, policyId PolicyId, params *PatchpolicyItemParams, body PatchpolicyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchpolicyItemRequest(c.Server, policyId, params, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.MaxResults != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PutpolicyItemWithBody(ctx context.Context, policyId PolicyId, params *PutpolicyItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutpolicyItemRequestWithBody(c.Server, policyId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostv1kubenamespacesRequest calls the generic Postv1kubenamespaces builder with application/json body
-func NewPostv1kubenamespacesRequest(server string, body Postv1kubenamespacesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutpolicyItem(ctx context.Context, policyId PolicyId, params *PutpolicyItemParams, body PutpolicyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutpolicyItemRequest(c.Server, policyId, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostv1kubenamespacesRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostv1kubenamespacesRequestWithBody generates requests for Postv1kubenamespaces with any type of body
-func NewPostv1kubenamespacesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) Getv1runkubebackup(ctx context.Context, params *Getv1runkubebackupParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1runkubebackupRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/kubenamespaces")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Postv1runkubebackupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1runkubebackupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteKubenamespaceItemRequest generates requests for DeleteKubenamespaceItem
-func NewDeleteKubenamespaceItemRequest(server string, kubenamespaceId KubenamespaceId, params *DeleteKubenamespaceItemParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubenamespaceId", runtime.ParamLocationPath, kubenamespaceId)
+func (c *Client) Postv1runkubebackup(ctx context.Context, body Postv1runkubebackupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1runkubebackupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteRunkubebackupItem(ctx context.Context, runkubebackupId RunkubebackupId, params *DeleteRunkubebackupItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRunkubebackupItemRequest(c.Server, runkubebackupId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/kubenamespaces/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetRunkubebackupItem(ctx context.Context, runkubebackupId RunkubebackupId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRunkubebackupItemRequest(c.Server, runkubebackupId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	var headerParam0 string
-
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+func (c *Client) PatchRunkubebackupItemWithBody(ctx context.Context, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchRunkubebackupItemRequestWithBody(c.Server, runkubebackupId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Set("If-Match", headerParam0)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetKubenamespaceItemRequest generates requests for GetKubenamespaceItem
-func NewGetKubenamespaceItemRequest(server string, kubenamespaceId KubenamespaceId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubenamespaceId", runtime.ParamLocationPath, kubenamespaceId)
+func (c *Client) PatchRunkubebackupItem(ctx context.Context, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, body PatchRunkubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchRunkubebackupItemRequest(c.Server, runkubebackupId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutRunkubebackupItemWithBody(ctx context.Context, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutRunkubebackupItemRequestWithBody(c.Server, runkubebackupId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/kubenamespaces/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutRunkubebackupItem(ctx context.Context, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, body PutRunkubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutRunkubebackupItemRequest(c.Server, runkubebackupId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPatchKubenamespaceItemRequest calls the generic PatchKubenamespaceItem builder with application/json body
-func NewPatchKubenamespaceItemRequest(server string, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, body PatchKubenamespaceItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) Getv1usergroups(ctx context.Context, params *Getv1usergroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1usergroupsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchKubenamespaceItemRequestWithBody(server, kubenamespaceId, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchKubenamespaceItemRequestWithBody generates requests for PatchKubenamespaceItem with any type of body
-func NewPatchKubenamespaceItemRequestWithBody(server string, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubenamespaceId", runtime.ParamLocationPath, kubenamespaceId)
+func (c *Client) Postv1usergroupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1usergroupsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) Postv1usergroups(ctx context.Context, body Postv1usergroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostv1usergroupsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/kubenamespaces/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteUsergroupItem(ctx context.Context, usergroupId UsergroupId, params *DeleteUsergroupItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsergroupItemRequest(c.Server, usergroupId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *Client) GetUsergroupItem(ctx context.Context, usergroupId UsergroupId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsergroupItemRequest(c.Server, usergroupId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	var headerParam0 string
-
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+func (c *Client) PatchUsergroupItemWithBody(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUsergroupItemRequestWithBody(c.Server, usergroupId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Set("If-Match", headerParam0)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutKubenamespaceItemRequest calls the generic PutKubenamespaceItem builder with application/json body
-func NewPutKubenamespaceItemRequest(server string, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, body PutKubenamespaceItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PatchUsergroupItem(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, body PatchUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUsergroupItemRequest(c.Server, usergroupId, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutKubenamespaceItemRequestWithBody(server, kubenamespaceId, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutKubenamespaceItemRequestWithBody generates requests for PutKubenamespaceItem with any type of body
-func NewPutKubenamespaceItemRequestWithBody(server string, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubenamespaceId", runtime.ParamLocationPath, kubenamespaceId)
+func (c *Client) PutUsergroupItemWithBody(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsergroupItemRequestWithBody(c.Server, usergroupId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutUsergroupItem(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, body PutUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsergroupItemRequest(c.Server, usergroupId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/kubenamespaces/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateUserGroupACLWithBody(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserGroupACLRequestWithBody(c.Server, usergroupId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) UpdateUserGroupACL(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, body UpdateUserGroupACLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserGroupACLRequest(c.Server, usergroupId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	var headerParam0 string
-
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+func (c *Client) Getv1users(ctx context.Context, params *Getv1usersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetv1usersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Set("If-Match", headerParam0)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeletev1orginvitesRequest generates requests for Deletev1orginvites
-func NewDeletev1orginvitesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetUserItem(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserItemRequest(c.Server, userId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/orginvites")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PatchUserItemWithBody(ctx context.Context, userId UserId, params *PatchUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUserItemRequestWithBody(c.Server, userId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchUserItem(ctx context.Context, userId UserId, params *PatchUserItemParams, body PatchUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUserItemRequest(c.Server, userId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) PutUserItemWithBody(ctx context.Context, userId UserId, params *PutUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUserItemRequestWithBody(c.Server, userId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PutUserItem(ctx context.Context, userId UserId, params *PutUserItemParams, body PutUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUserItemRequest(c.Server, userId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetv1orginvitesRequest generates requests for Getv1orginvites
-func NewGetv1orginvitesRequest(server string, params *Getv1orginvitesParams) (*http.Request, error) {
+// NewGetv1backupinstancesRequest generates requests for Getv1backupinstances
+func NewGetv1backupinstancesRequest(server string, params *Getv1backupinstancesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2080,7 +3166,7 @@ func NewGetv1orginvitesRequest(server string, params *Getv1orginvitesParams) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orginvites")
+	operationPath := fmt.Sprintf("/v1/backupinstances")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2182,19 +3268,19 @@ func NewGetv1orginvitesRequest(server string, params *Getv1orginvitesParams) (*h
 	return req, nil
 }
 
-// NewPostv1orginvitesRequest calls the generic Postv1orginvites builder with application/json body
-func NewPostv1orginvitesRequest(server string, body Postv1orginvitesJSONRequestBody) (*http.Request, error) {
+// NewPostv1backupinstancesRequest calls the generic Postv1backupinstances builder with application/json body
+func NewPostv1backupinstancesRequest(server string, body Postv1backupinstancesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostv1orginvitesRequestWithBody(server, "application/json", bodyReader)
+	return NewPostv1backupinstancesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostv1orginvitesRequestWithBody generates requests for Postv1orginvites with any type of body
-func NewPostv1orginvitesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostv1backupinstancesRequestWithBody generates requests for Postv1backupinstances with any type of body
+func NewPostv1backupinstancesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2202,7 +3288,7 @@ func NewPostv1orginvitesRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orginvites")
+	operationPath := fmt.Sprintf("/v1/backupinstances")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2222,13 +3308,13 @@ func NewPostv1orginvitesRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
-// NewDeleteOrginviteItemRequest generates requests for DeleteOrginviteItem
-func NewDeleteOrginviteItemRequest(server string, orginviteId OrginviteId, params *DeleteOrginviteItemParams) (*http.Request, error) {
+// NewDeleteBackupinstanceItemRequest generates requests for DeleteBackupinstanceItem
+func NewDeleteBackupinstanceItemRequest(server string, backupinstanceId BackupinstanceId, params *DeleteBackupinstanceItemParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orginviteId", runtime.ParamLocationPath, orginviteId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "backupinstanceId", runtime.ParamLocationPath, backupinstanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -2238,7 +3324,7 @@ func NewDeleteOrginviteItemRequest(server string, orginviteId OrginviteId, param
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orginvites/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/backupinstances/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2265,13 +3351,13 @@ func NewDeleteOrginviteItemRequest(server string, orginviteId OrginviteId, param
 	return req, nil
 }
 
-// NewGetOrginviteItemRequest generates requests for GetOrginviteItem
-func NewGetOrginviteItemRequest(server string, orginviteId OrginviteId) (*http.Request, error) {
+// NewGetBackupinstanceItemRequest generates requests for GetBackupinstanceItem
+func NewGetBackupinstanceItemRequest(server string, backupinstanceId BackupinstanceId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orginviteId", runtime.ParamLocationPath, orginviteId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "backupinstanceId", runtime.ParamLocationPath, backupinstanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -2281,7 +3367,7 @@ func NewGetOrginviteItemRequest(server string, orginviteId OrginviteId) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orginvites/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/backupinstances/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2299,24 +3385,24 @@ func NewGetOrginviteItemRequest(server string, orginviteId OrginviteId) (*http.R
 	return req, nil
 }
 
-// NewPatchOrginviteItemRequest calls the generic PatchOrginviteItem builder with application/json body
-func NewPatchOrginviteItemRequest(server string, orginviteId OrginviteId, params *PatchOrginviteItemParams, body PatchOrginviteItemJSONRequestBody) (*http.Request, error) {
+// NewPatchBackupinstanceItemRequest calls the generic PatchBackupinstanceItem builder with application/json body
+func NewPatchBackupinstanceItemRequest(server string, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, body PatchBackupinstanceItemJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchOrginviteItemRequestWithBody(server, orginviteId, params, "application/json", bodyReader)
+	return NewPatchBackupinstanceItemRequestWithBody(server, backupinstanceId, params, "application/json", bodyReader)
 }
 
-// NewPatchOrginviteItemRequestWithBody generates requests for PatchOrginviteItem with any type of body
-func NewPatchOrginviteItemRequestWithBody(server string, orginviteId OrginviteId, params *PatchOrginviteItemParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchBackupinstanceItemRequestWithBody generates requests for PatchBackupinstanceItem with any type of body
+func NewPatchBackupinstanceItemRequestWithBody(server string, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orginviteId", runtime.ParamLocationPath, orginviteId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "backupinstanceId", runtime.ParamLocationPath, backupinstanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -2326,7 +3412,7 @@ func NewPatchOrginviteItemRequestWithBody(server string, orginviteId OrginviteId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orginvites/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/backupinstances/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2355,24 +3441,24 @@ func NewPatchOrginviteItemRequestWithBody(server string, orginviteId OrginviteId
 	return req, nil
 }
 
-// NewPutOrginviteItemRequest calls the generic PutOrginviteItem builder with application/json body
-func NewPutOrginviteItemRequest(server string, orginviteId OrginviteId, params *PutOrginviteItemParams, body PutOrginviteItemJSONRequestBody) (*http.Request, error) {
+// NewPutBackupinstanceItemRequest calls the generic PutBackupinstanceItem builder with application/json body
+func NewPutBackupinstanceItemRequest(server string, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, body PutBackupinstanceItemJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutOrginviteItemRequestWithBody(server, orginviteId, params, "application/json", bodyReader)
+	return NewPutBackupinstanceItemRequestWithBody(server, backupinstanceId, params, "application/json", bodyReader)
 }
 
-// NewPutOrginviteItemRequestWithBody generates requests for PutOrginviteItem with any type of body
-func NewPutOrginviteItemRequestWithBody(server string, orginviteId OrginviteId, params *PutOrginviteItemParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutBackupinstanceItemRequestWithBody generates requests for PutBackupinstanceItem with any type of body
+func NewPutBackupinstanceItemRequestWithBody(server string, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orginviteId", runtime.ParamLocationPath, orginviteId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "backupinstanceId", runtime.ParamLocationPath, backupinstanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -2382,7 +3468,7 @@ func NewPutOrginviteItemRequestWithBody(server string, orginviteId OrginviteId,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orginvites/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/backupinstances/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2411,8 +3497,8 @@ func NewPutOrginviteItemRequestWithBody(server string, orginviteId OrginviteId,
 	return req, nil
 }
 
-// NewGetv1orgsRequest generates requests for Getv1orgs
-func NewGetv1orgsRequest(server string, params *Getv1orgsParams) (*http.Request, error) {
+// NewGetv1internalaclsRequest generates requests for Getv1internalacls
+func NewGetv1internalaclsRequest(server string, params *Getv1internalaclsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2420,7 +3506,7 @@ func NewGetv1orgsRequest(server string, params *Getv1orgsParams) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orgs")
+	operationPath := fmt.Sprintf("/v1/internalacls")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2522,23 +3608,27 @@ func NewGetv1orgsRequest(server string, params *Getv1orgsParams) (*http.Request,
 	return req, nil
 }
 
-// NewGetOrgItemRequest generates requests for GetOrgItem
-func NewGetOrgItemRequest(server string, orgId OrgId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+// NewPostv1internalaclsRequest calls the generic Postv1internalacls builder with application/json body
+func NewPostv1internalaclsRequest(server string, body Postv1internalaclsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostv1internalaclsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostv1internalaclsRequestWithBody generates requests for Postv1internalacls with any type of body
+func NewPostv1internalaclsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orgs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/internalacls")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2548,32 +3638,23 @@ func NewGetOrgItemRequest(server string, orgId OrgId) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewPatchOrgItemRequest calls the generic PatchOrgItem builder with application/json body
-func NewPatchOrgItemRequest(server string, orgId OrgId, params *PatchOrgItemParams, body PatchOrgItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchOrgItemRequestWithBody(server, orgId, params, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewPatchOrgItemRequestWithBody generates requests for PatchOrgItem with any type of body
-func NewPatchOrgItemRequestWithBody(server string, orgId OrgId, params *PatchOrgItemParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteInternalaclItemRequest generates requests for DeleteInternalaclItem
+func NewDeleteInternalaclItemRequest(server string, internalaclId InternalaclId, params *DeleteInternalaclItemParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "internalaclId", runtime.ParamLocationPath, internalaclId)
 	if err != nil {
 		return nil, err
 	}
@@ -2583,7 +3664,7 @@ func NewPatchOrgItemRequestWithBody(server string, orgId OrgId, params *PatchOrg
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orgs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/internalacls/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2593,13 +3674,11 @@ func NewPatchOrgItemRequestWithBody(server string, orgId OrgId, params *PatchOrg
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	var headerParam0 string
 
 	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
@@ -2612,24 +3691,13 @@ func NewPatchOrgItemRequestWithBody(server string, orgId OrgId, params *PatchOrg
 	return req, nil
 }
 
-// NewPutOrgItemRequest calls the generic PutOrgItem builder with application/json body
-func NewPutOrgItemRequest(server string, orgId OrgId, params *PutOrgItemParams, body PutOrgItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutOrgItemRequestWithBody(server, orgId, params, "application/json", bodyReader)
-}
-
-// NewPutOrgItemRequestWithBody generates requests for PutOrgItem with any type of body
-func NewPutOrgItemRequestWithBody(server string, orgId OrgId, params *PutOrgItemParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetInternalaclItemRequest generates requests for GetInternalaclItem
+func NewGetInternalaclItemRequest(server string, internalaclId InternalaclId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "internalaclId", runtime.ParamLocationPath, internalaclId)
 	if err != nil {
 		return nil, err
 	}
@@ -2639,7 +3707,7 @@ func NewPutOrgItemRequestWithBody(server string, orgId OrgId, params *PutOrgItem
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/orgs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/internalacls/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2649,7 +3717,52 @@ func NewPutOrgItemRequestWithBody(server string, orgId OrgId, params *PutOrgItem
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchInternalaclItemRequest calls the generic PatchInternalaclItem builder with application/json body
+func NewPatchInternalaclItemRequest(server string, internalaclId InternalaclId, params *PatchInternalaclItemParams, body PatchInternalaclItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchInternalaclItemRequestWithBody(server, internalaclId, params, "application/json", bodyReader)
+}
+
+// NewPatchInternalaclItemRequestWithBody generates requests for PatchInternalaclItem with any type of body
+func NewPatchInternalaclItemRequestWithBody(server string, internalaclId InternalaclId, params *PatchInternalaclItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "internalaclId", runtime.ParamLocationPath, internalaclId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/internalacls/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2668,8 +3781,64 @@ func NewPutOrgItemRequestWithBody(server string, orgId OrgId, params *PutOrgItem
 	return req, nil
 }
 
-// NewGetv1usergroupsRequest generates requests for Getv1usergroups
-func NewGetv1usergroupsRequest(server string, params *Getv1usergroupsParams) (*http.Request, error) {
+// NewPutInternalaclItemRequest calls the generic PutInternalaclItem builder with application/json body
+func NewPutInternalaclItemRequest(server string, internalaclId InternalaclId, params *PutInternalaclItemParams, body PutInternalaclItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutInternalaclItemRequestWithBody(server, internalaclId, params, "application/json", bodyReader)
+}
+
+// NewPutInternalaclItemRequestWithBody generates requests for PutInternalaclItem with any type of body
+func NewPutInternalaclItemRequestWithBody(server string, internalaclId InternalaclId, params *PutInternalaclItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "internalaclId", runtime.ParamLocationPath, internalaclId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/internalacls/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewDeletev1jobsRequest generates requests for Deletev1jobs
+func NewDeletev1jobsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2677,7 +3846,34 @@ func NewGetv1usergroupsRequest(server string, params *Getv1usergroupsParams) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/usergroups")
+	operationPath := fmt.Sprintf("/v1/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetv1jobsRequest generates requests for Getv1jobs
+func NewGetv1jobsRequest(server string, params *Getv1jobsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/jobs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2779,19 +3975,19 @@ func NewGetv1usergroupsRequest(server string, params *Getv1usergroupsParams) (*h
 	return req, nil
 }
 
-// NewPostv1usergroupsRequest calls the generic Postv1usergroups builder with application/json body
-func NewPostv1usergroupsRequest(server string, body Postv1usergroupsJSONRequestBody) (*http.Request, error) {
+// NewPostv1jobsRequest calls the generic Postv1jobs builder with application/json body
+func NewPostv1jobsRequest(server string, body Postv1jobsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostv1usergroupsRequestWithBody(server, "application/json", bodyReader)
+	return NewPostv1jobsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostv1usergroupsRequestWithBody generates requests for Postv1usergroups with any type of body
-func NewPostv1usergroupsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostv1jobsRequestWithBody generates requests for Postv1jobs with any type of body
+func NewPostv1jobsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2799,7 +3995,7 @@ func NewPostv1usergroupsRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/usergroups")
+	operationPath := fmt.Sprintf("/v1/jobs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2819,13 +4015,13 @@ func NewPostv1usergroupsRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
-// NewDeleteUsergroupItemRequest generates requests for DeleteUsergroupItem
-func NewDeleteUsergroupItemRequest(server string, usergroupId UsergroupId, params *DeleteUsergroupItemParams) (*http.Request, error) {
+// NewDeleteJobItemRequest generates requests for DeleteJobItem
+func NewDeleteJobItemRequest(server string, jobId JobId, params *DeleteJobItemParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "jobId", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2835,7 +4031,7 @@ func NewDeleteUsergroupItemRequest(server string, usergroupId UsergroupId, param
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/usergroups/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/jobs/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2862,13 +4058,13 @@ func NewDeleteUsergroupItemRequest(server string, usergroupId UsergroupId, param
 	return req, nil
 }
 
-// NewGetUsergroupItemRequest generates requests for GetUsergroupItem
-func NewGetUsergroupItemRequest(server string, usergroupId UsergroupId) (*http.Request, error) {
+// NewGetJobItemRequest generates requests for GetJobItem
+func NewGetJobItemRequest(server string, jobId JobId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "jobId", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2878,7 +4074,7 @@ func NewGetUsergroupItemRequest(server string, usergroupId UsergroupId) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/usergroups/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/jobs/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2896,24 +4092,24 @@ func NewGetUsergroupItemRequest(server string, usergroupId UsergroupId) (*http.R
 	return req, nil
 }
 
-// NewPatchUsergroupItemRequest calls the generic PatchUsergroupItem builder with application/json body
-func NewPatchUsergroupItemRequest(server string, usergroupId UsergroupId, params *PatchUsergroupItemParams, body PatchUsergroupItemJSONRequestBody) (*http.Request, error) {
+// NewPatchJobItemRequest calls the generic PatchJobItem builder with application/json body
+func NewPatchJobItemRequest(server string, jobId JobId, params *PatchJobItemParams, body PatchJobItemJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchUsergroupItemRequestWithBody(server, usergroupId, params, "application/json", bodyReader)
+	return NewPatchJobItemRequestWithBody(server, jobId, params, "application/json", bodyReader)
 }
 
-// NewPatchUsergroupItemRequestWithBody generates requests for PatchUsergroupItem with any type of body
-func NewPatchUsergroupItemRequestWithBody(server string, usergroupId UsergroupId, params *PatchUsergroupItemParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchJobItemRequestWithBody generates requests for PatchJobItem with any type of body
+func NewPatchJobItemRequestWithBody(server string, jobId JobId, params *PatchJobItemParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "jobId", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2923,7 +4119,7 @@ func NewPatchUsergroupItemRequestWithBody(server string, usergroupId UsergroupId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/usergroups/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/jobs/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2952,24 +4148,24 @@ func NewPatchUsergroupItemRequestWithBody(server string, usergroupId UsergroupId
 	return req, nil
 }
 
-// NewPutUsergroupItemRequest calls the generic PutUsergroupItem builder with application/json body
-func NewPutUsergroupItemRequest(server string, usergroupId UsergroupId, params *PutUsergroupItemParams, body PutUsergroupItemJSONRequestBody) (*http.Request, error) {
+// NewPutJobItemRequest calls the generic PutJobItem builder with application/json body
+func NewPutJobItemRequest(server string, jobId JobId, params *PutJobItemParams, body PutJobItemJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutUsergroupItemRequestWithBody(server, usergroupId, params, "application/json", bodyReader)
+	return NewPutJobItemRequestWithBody(server, jobId, params, "application/json", bodyReader)
 }
 
-// NewPutUsergroupItemRequestWithBody generates requests for PutUsergroupItem with any type of body
-func NewPutUsergroupItemRequestWithBody(server string, usergroupId UsergroupId, params *PutUsergroupItemParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutJobItemRequestWithBody generates requests for PutJobItem with any type of body
+func NewPutJobItemRequestWithBody(server string, jobId JobId, params *PutJobItemParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "jobId", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -2979,7 +4175,7 @@ func NewPutUsergroupItemRequestWithBody(server string, usergroupId UsergroupId,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/usergroups/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/jobs/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3008,64 +4204,8 @@ func NewPutUsergroupItemRequestWithBody(server string, usergroupId UsergroupId,
 	return req, nil
 }
 
-// NewUpdateUserGroupACLRequest calls the generic UpdateUserGroupACL builder with application/json body
-func NewUpdateUserGroupACLRequest(server string, usergroupId UsergroupId, params *UpdateUserGroupACLParams, body UpdateUserGroupACLJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateUserGroupACLRequestWithBody(server, usergroupId, params, "application/json", bodyReader)
-}
-
-// NewUpdateUserGroupACLRequestWithBody generates requests for UpdateUserGroupACL with any type of body
-func NewUpdateUserGroupACLRequestWithBody(server string, usergroupId UsergroupId, params *UpdateUserGroupACLParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/v1/usergroups/%s/action/update-acls", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	var headerParam0 string
-
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("If-Match", headerParam0)
-
-	return req, nil
-}
-
-// NewGetv1usersRequest generates requests for Getv1users
-func NewGetv1usersRequest(server string, params *Getv1usersParams) (*http.Request, error) {
+// NewGetv1kubebackupsRequest generates requests for Getv1kubebackups
+func NewGetv1kubebackupsRequest(server string, params *Getv1kubebackupsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3073,7 +4213,7 @@ func NewGetv1usersRequest(server string, params *Getv1usersParams) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/users")
+	operationPath := fmt.Sprintf("/v1/kubebackups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3175,23 +4315,27 @@ func NewGetv1usersRequest(server string, params *Getv1usersParams) (*http.Reques
 	return req, nil
 }
 
-// NewGetUserItemRequest generates requests for GetUserItem
-func NewGetUserItemRequest(server string, userId UserId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+// NewPostv1kubebackupsRequest calls the generic Postv1kubebackups builder with application/json body
+func NewPostv1kubebackupsRequest(server string, body Postv1kubebackupsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostv1kubebackupsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostv1kubebackupsRequestWithBody generates requests for Postv1kubebackups with any type of body
+func NewPostv1kubebackupsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/kubebackups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3201,32 +4345,23 @@ func NewGetUserItemRequest(server string, userId UserId) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewPatchUserItemRequest calls the generic PatchUserItem builder with application/json body
-func NewPatchUserItemRequest(server string, userId UserId, params *PatchUserItemParams, body PatchUserItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchUserItemRequestWithBody(server, userId, params, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewPatchUserItemRequestWithBody generates requests for PatchUserItem with any type of body
-func NewPatchUserItemRequestWithBody(server string, userId UserId, params *PatchUserItemParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteKubebackupItemRequest generates requests for DeleteKubebackupItem
+func NewDeleteKubebackupItemRequest(server string, kubebackupId KubebackupId, params *DeleteKubebackupItemParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubebackupId", runtime.ParamLocationPath, kubebackupId)
 	if err != nil {
 		return nil, err
 	}
@@ -3236,7 +4371,7 @@ func NewPatchUserItemRequestWithBody(server string, userId UserId, params *Patch
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/kubebackups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3246,13 +4381,11 @@ func NewPatchUserItemRequestWithBody(server string, userId UserId, params *Patch
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	var headerParam0 string
 
 	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
@@ -3265,24 +4398,58 @@ func NewPatchUserItemRequestWithBody(server string, userId UserId, params *Patch
 	return req, nil
 }
 
-// NewPutUserItemRequest calls the generic PutUserItem builder with application/json body
-func NewPutUserItemRequest(server string, userId UserId, params *PutUserItemParams, body PutUserItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetKubebackupItemRequest generates requests for GetKubebackupItem
+func NewGetKubebackupItemRequest(server string, kubebackupId KubebackupId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubebackupId", runtime.ParamLocationPath, kubebackupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kubebackups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchKubebackupItemRequest calls the generic PatchKubebackupItem builder with application/json body
+func NewPatchKubebackupItemRequest(server string, kubebackupId KubebackupId, params *PatchKubebackupItemParams, body PatchKubebackupItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutUserItemRequestWithBody(server, userId, params, "application/json", bodyReader)
+	return NewPatchKubebackupItemRequestWithBody(server, kubebackupId, params, "application/json", bodyReader)
 }
 
-// NewPutUserItemRequestWithBody generates requests for PutUserItem with any type of body
-func NewPutUserItemRequestWithBody(server string, userId UserId, params *PutUserItemParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchKubebackupItemRequestWithBody generates requests for PatchKubebackupItem with any type of body
+func NewPatchKubebackupItemRequestWithBody(server string, kubebackupId KubebackupId, params *PatchKubebackupItemParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubebackupId", runtime.ParamLocationPath, kubebackupId)
 	if err != nil {
 		return nil, err
 	}
@@ -3292,7 +4459,7 @@ func NewPutUserItemRequestWithBody(server string, userId UserId, params *PutUser
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/kubebackups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3302,7 +4469,7 @@ func NewPutUserItemRequestWithBody(server string, userId UserId, params *PutUser
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3321,1456 +4488,7326 @@ func NewPutUserItemRequestWithBody(server string, userId UserId, params *PutUser
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewPutKubebackupItemRequest calls the generic PutKubebackupItem builder with application/json body
+func NewPutKubebackupItemRequest(server string, kubebackupId KubebackupId, params *PutKubebackupItemParams, body PutKubebackupItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPutKubebackupItemRequestWithBody(server, kubebackupId, params, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewPutKubebackupItemRequestWithBody generates requests for PutKubebackupItem with any type of body
+func NewPutKubebackupItemRequestWithBody(server string, kubebackupId KubebackupId, params *PutKubebackupItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubebackupId", runtime.ParamLocationPath, kubebackupId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// Getv1internalacls request
-	Getv1internalaclsWithResponse(ctx context.Context, params *Getv1internalaclsParams, reqEditors ...RequestEditorFn) (*Getv1internalaclsResponse, error)
 
-	// Postv1internalacls request with any body
-	Postv1internalaclsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1internalaclsResponse, error)
+	operationPath := fmt.Sprintf("/v1/kubebackups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	Postv1internalaclsWithResponse(ctx context.Context, body Postv1internalaclsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1internalaclsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteInternalaclItem request
-	DeleteInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *DeleteInternalaclItemParams, reqEditors ...RequestEditorFn) (*DeleteInternalaclItemResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetInternalaclItem request
-	GetInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, reqEditors ...RequestEditorFn) (*GetInternalaclItemResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// PatchInternalaclItem request with any body
-	PatchInternalaclItemWithBodyWithResponse(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchInternalaclItemResponse, error)
+	var headerParam0 string
 
-	PatchInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, body PatchInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchInternalaclItemResponse, error)
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutInternalaclItem request with any body
-	PutInternalaclItemWithBodyWithResponse(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutInternalaclItemResponse, error)
+	req.Header.Set("If-Match", headerParam0)
 
-	PutInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, body PutInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutInternalaclItemResponse, error)
+	return req, nil
+}
 
-	// Deletev1kubenamespaces request
-	Deletev1kubenamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1kubenamespacesResponse, error)
+// NewGetv1kubeclustersRequest generates requests for Getv1kubeclusters
+func NewGetv1kubeclustersRequest(server string, params *Getv1kubeclustersParams) (*http.Request, error) {
+	var err error
 
-	// Getv1kubenamespaces request
-	Getv1kubenamespacesWithResponse(ctx context.Context, params *Getv1kubenamespacesParams, reqEditors ...RequestEditorFn) (*Getv1kubenamespacesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// Postv1kubenamespaces request with any body
-	Postv1kubenamespacesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kubenamespacesResponse, error)
+	operationPath := fmt.Sprintf("/v1/kubeclusters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	Postv1kubenamespacesWithResponse(ctx context.Context, body Postv1kubenamespacesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kubenamespacesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteKubenamespaceItem request
-	DeleteKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *DeleteKubenamespaceItemParams, reqEditors ...RequestEditorFn) (*DeleteKubenamespaceItemResponse, error)
+	queryValues := queryURL.Query()
 
-	// GetKubenamespaceItem request
-	GetKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, reqEditors ...RequestEditorFn) (*GetKubenamespaceItemResponse, error)
+	if params.Where != nil {
 
-	// PatchKubenamespaceItem request with any body
-	PatchKubenamespaceItemWithBodyWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKubenamespaceItemResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PatchKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, body PatchKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKubenamespaceItemResponse, error)
+	}
 
-	// PutKubenamespaceItem request with any body
-	PutKubenamespaceItemWithBodyWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKubenamespaceItemResponse, error)
+	if params.Projection != nil {
 
-	PutKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, body PutKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKubenamespaceItemResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// Deletev1orginvites request
-	Deletev1orginvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1orginvitesResponse, error)
+	}
 
-	// Getv1orginvites request
-	Getv1orginvitesWithResponse(ctx context.Context, params *Getv1orginvitesParams, reqEditors ...RequestEditorFn) (*Getv1orginvitesResponse, error)
+	if params.Sort != nil {
 
-	// Postv1orginvites request with any body
-	Postv1orginvitesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1orginvitesResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	Postv1orginvitesWithResponse(ctx context.Context, body Postv1orginvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1orginvitesResponse, error)
+	}
 
-	// DeleteOrginviteItem request
-	DeleteOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *DeleteOrginviteItemParams, reqEditors ...RequestEditorFn) (*DeleteOrginviteItemResponse, error)
+	if params.Page != nil {
 
-	// GetOrginviteItem request
-	GetOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, reqEditors ...RequestEditorFn) (*GetOrginviteItemResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// PatchOrginviteItem request with any body
-	PatchOrginviteItemWithBodyWithResponse(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrginviteItemResponse, error)
+	}
 
-	PatchOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, body PatchOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrginviteItemResponse, error)
+	if params.MaxResults != nil {
 
-	// PutOrginviteItem request with any body
-	PutOrginviteItemWithBodyWithResponse(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrginviteItemResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PutOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, body PutOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrginviteItemResponse, error)
+	}
 
-	// Getv1orgs request
-	Getv1orgsWithResponse(ctx context.Context, params *Getv1orgsParams, reqEditors ...RequestEditorFn) (*Getv1orgsResponse, error)
+	queryURL.RawQuery = queryValues.Encode()
 
-	// GetOrgItem request
-	GetOrgItemWithResponse(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*GetOrgItemResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchOrgItem request with any body
-	PatchOrgItemWithBodyWithResponse(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrgItemResponse, error)
+	return req, nil
+}
 
-	PatchOrgItemWithResponse(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, body PatchOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrgItemResponse, error)
+// NewPostv1kubeclustersRequest calls the generic Postv1kubeclusters builder with application/json body
+func NewPostv1kubeclustersRequest(server string, body Postv1kubeclustersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostv1kubeclustersRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// PutOrgItem request with any body
-	PutOrgItemWithBodyWithResponse(ctx context.Context, orgId OrgId, params *PutOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrgItemResponse, error)
+// NewPostv1kubeclustersRequestWithBody generates requests for Postv1kubeclusters with any type of body
+func NewPostv1kubeclustersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	PutOrgItemWithResponse(ctx context.Context, orgId OrgId, params *PutOrgItemParams, body PutOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrgItemResponse, error)
-
-	// Getv1usergroups request
-	Getv1usergroupsWithResponse(ctx context.Context, params *Getv1usergroupsParams, reqEditors ...RequestEditorFn) (*Getv1usergroupsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// Postv1usergroups request with any body
-	Postv1usergroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1usergroupsResponse, error)
+	operationPath := fmt.Sprintf("/v1/kubeclusters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	Postv1usergroupsWithResponse(ctx context.Context, body Postv1usergroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1usergroupsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteUsergroupItem request
-	DeleteUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *DeleteUsergroupItemParams, reqEditors ...RequestEditorFn) (*DeleteUsergroupItemResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetUsergroupItem request
-	GetUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, reqEditors ...RequestEditorFn) (*GetUsergroupItemResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// PatchUsergroupItem request with any body
-	PatchUsergroupItemWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsergroupItemResponse, error)
+	return req, nil
+}
 
-	PatchUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, body PatchUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsergroupItemResponse, error)
+// NewDeleteKubeclusterItemRequest generates requests for DeleteKubeclusterItem
+func NewDeleteKubeclusterItemRequest(server string, kubeclusterId KubeclusterId, params *DeleteKubeclusterItemParams) (*http.Request, error) {
+	var err error
 
-	// PutUsergroupItem request with any body
-	PutUsergroupItemWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsergroupItemResponse, error)
+	var pathParam0 string
 
-	PutUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, body PutUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsergroupItemResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubeclusterId", runtime.ParamLocationPath, kubeclusterId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateUserGroupACL request with any body
-	UpdateUserGroupACLWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserGroupACLResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateUserGroupACLWithResponse(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, body UpdateUserGroupACLJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserGroupACLResponse, error)
+	operationPath := fmt.Sprintf("/v1/kubeclusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// Getv1users request
-	Getv1usersWithResponse(ctx context.Context, params *Getv1usersParams, reqEditors ...RequestEditorFn) (*Getv1usersResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetUserItem request
-	GetUserItemWithResponse(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*GetUserItemResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchUserItem request with any body
-	PatchUserItemWithBodyWithResponse(ctx context.Context, userId UserId, params *PatchUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUserItemResponse, error)
+	var headerParam0 string
 
-	PatchUserItemWithResponse(ctx context.Context, userId UserId, params *PatchUserItemParams, body PatchUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUserItemResponse, error)
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutUserItem request with any body
-	PutUserItemWithBodyWithResponse(ctx context.Context, userId UserId, params *PutUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUserItemResponse, error)
+	req.Header.Set("If-Match", headerParam0)
 
-	PutUserItemWithResponse(ctx context.Context, userId UserId, params *PutUserItemParams, body PutUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUserItemResponse, error)
+	return req, nil
 }
 
-type Getv1internalaclsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Items *[]Internalacl   `json:"_items,omitempty"`
-		Links *ResponeLinks    `json:"_links,omitempty"`
-		Meta  *ResponeMetadata `json:"_meta,omitempty"`
-	}
-	JSONDefault *Error
-}
+// NewGetKubeclusterItemRequest generates requests for GetKubeclusterItem
+func NewGetKubeclusterItemRequest(server string, kubeclusterId KubeclusterId) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r Getv1internalaclsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubeclusterId", runtime.ParamLocationPath, kubeclusterId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Getv1internalaclsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type Postv1internalaclsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	operationPath := fmt.Sprintf("/v1/kubeclusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r Postv1internalaclsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Postv1internalaclsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteInternalaclItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteInternalaclItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPatchKubeclusterItemRequest calls the generic PatchKubeclusterItem builder with application/json body
+func NewPatchKubeclusterItemRequest(server string, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, body PatchKubeclusterItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchKubeclusterItemRequestWithBody(server, kubeclusterId, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteInternalaclItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewPatchKubeclusterItemRequestWithBody generates requests for PatchKubeclusterItem with any type of body
+func NewPatchKubeclusterItemRequestWithBody(server string, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetInternalaclItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Internalacl
-	JSONDefault  *Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetInternalaclItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubeclusterId", runtime.ParamLocationPath, kubeclusterId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetInternalaclItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PatchInternalaclItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	operationPath := fmt.Sprintf("/v1/kubeclusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PatchInternalaclItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchInternalaclItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutInternalaclItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r PutInternalaclItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var headerParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutInternalaclItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type Deletev1kubenamespacesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r Deletev1kubenamespacesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPutKubeclusterItemRequest calls the generic PutKubeclusterItem builder with application/json body
+func NewPutKubeclusterItemRequest(server string, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, body PutKubeclusterItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutKubeclusterItemRequestWithBody(server, kubeclusterId, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Deletev1kubenamespacesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPutKubeclusterItemRequestWithBody generates requests for PutKubeclusterItem with any type of body
+func NewPutKubeclusterItemRequestWithBody(server string, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubeclusterId", runtime.ParamLocationPath, kubeclusterId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type Getv1kubenamespacesResponse struct {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kubeclusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewDeletev1kubenamespacesRequest generates requests for Deletev1kubenamespaces
+func NewDeletev1kubenamespacesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kubenamespaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetv1kubenamespacesRequest generates requests for Getv1kubenamespaces
+func NewGetv1kubenamespacesRequest(server string, params *Getv1kubenamespacesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kubenamespaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Where != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Projection != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxResults != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostv1kubenamespacesRequest calls the generic Postv1kubenamespaces builder with application/json body
+func NewPostv1kubenamespacesRequest(server string, body Postv1kubenamespacesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostv1kubenamespacesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostv1kubenamespacesRequestWithBody generates requests for Postv1kubenamespaces with any type of body
+func NewPostv1kubenamespacesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kubenamespaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteKubenamespaceItemRequest generates requests for DeleteKubenamespaceItem
+func NewDeleteKubenamespaceItemRequest(server string, kubenamespaceId KubenamespaceId, params *DeleteKubenamespaceItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubenamespaceId", runtime.ParamLocationPath, kubenamespaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kubenamespaces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetKubenamespaceItemRequest generates requests for GetKubenamespaceItem
+func NewGetKubenamespaceItemRequest(server string, kubenamespaceId KubenamespaceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubenamespaceId", runtime.ParamLocationPath, kubenamespaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kubenamespaces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchKubenamespaceItemRequest calls the generic PatchKubenamespaceItem builder with application/json body
+func NewPatchKubenamespaceItemRequest(server string, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, body PatchKubenamespaceItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchKubenamespaceItemRequestWithBody(server, kubenamespaceId, params, "application/json", bodyReader)
+}
+
+// NewPatchKubenamespaceItemRequestWithBody generates requests for PatchKubenamespaceItem with any type of body
+func NewPatchKubenamespaceItemRequestWithBody(server string, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubenamespaceId", runtime.ParamLocationPath, kubenamespaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kubenamespaces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewPutKubenamespaceItemRequest calls the generic PutKubenamespaceItem builder with application/json body
+func NewPutKubenamespaceItemRequest(server string, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, body PutKubenamespaceItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutKubenamespaceItemRequestWithBody(server, kubenamespaceId, params, "application/json", bodyReader)
+}
+
+// NewPutKubenamespaceItemRequestWithBody generates requests for PutKubenamespaceItem with any type of body
+func NewPutKubenamespaceItemRequestWithBody(server string, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kubenamespaceId", runtime.ParamLocationPath, kubenamespaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kubenamespaces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetv1kuberestoresRequest generates requests for Getv1kuberestores
+func NewGetv1kuberestoresRequest(server string, params *Getv1kuberestoresParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kuberestores")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Where != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Projection != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxResults != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostv1kuberestoresRequest calls the generic Postv1kuberestores builder with application/json body
+func NewPostv1kuberestoresRequest(server string, body Postv1kuberestoresJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostv1kuberestoresRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostv1kuberestoresRequestWithBody generates requests for Postv1kuberestores with any type of body
+func NewPostv1kuberestoresRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kuberestores")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteKuberestoreItemRequest generates requests for DeleteKuberestoreItem
+func NewDeleteKuberestoreItemRequest(server string, kuberestoreId KuberestoreId, params *DeleteKuberestoreItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kuberestoreId", runtime.ParamLocationPath, kuberestoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kuberestores/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetKuberestoreItemRequest generates requests for GetKuberestoreItem
+func NewGetKuberestoreItemRequest(server string, kuberestoreId KuberestoreId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kuberestoreId", runtime.ParamLocationPath, kuberestoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kuberestores/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchKuberestoreItemRequest calls the generic PatchKuberestoreItem builder with application/json body
+func NewPatchKuberestoreItemRequest(server string, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, body PatchKuberestoreItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchKuberestoreItemRequestWithBody(server, kuberestoreId, params, "application/json", bodyReader)
+}
+
+// NewPatchKuberestoreItemRequestWithBody generates requests for PatchKuberestoreItem with any type of body
+func NewPatchKuberestoreItemRequestWithBody(server string, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kuberestoreId", runtime.ParamLocationPath, kuberestoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kuberestores/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewPutKuberestoreItemRequest calls the generic PutKuberestoreItem builder with application/json body
+func NewPutKuberestoreItemRequest(server string, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, body PutKuberestoreItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutKuberestoreItemRequestWithBody(server, kuberestoreId, params, "application/json", bodyReader)
+}
+
+// NewPutKuberestoreItemRequestWithBody generates requests for PutKuberestoreItem with any type of body
+func NewPutKuberestoreItemRequestWithBody(server string, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kuberestoreId", runtime.ParamLocationPath, kuberestoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/kuberestores/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewDeletev1orginvitesRequest generates requests for Deletev1orginvites
+func NewDeletev1orginvitesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orginvites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetv1orginvitesRequest generates requests for Getv1orginvites
+func NewGetv1orginvitesRequest(server string, params *Getv1orginvitesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orginvites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Where != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Projection != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxResults != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostv1orginvitesRequest calls the generic Postv1orginvites builder with application/json body
+func NewPostv1orginvitesRequest(server string, body Postv1orginvitesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostv1orginvitesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostv1orginvitesRequestWithBody generates requests for Postv1orginvites with any type of body
+func NewPostv1orginvitesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orginvites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOrginviteItemRequest generates requests for DeleteOrginviteItem
+func NewDeleteOrginviteItemRequest(server string, orginviteId OrginviteId, params *DeleteOrginviteItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orginviteId", runtime.ParamLocationPath, orginviteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orginvites/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetOrginviteItemRequest generates requests for GetOrginviteItem
+func NewGetOrginviteItemRequest(server string, orginviteId OrginviteId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orginviteId", runtime.ParamLocationPath, orginviteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orginvites/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchOrginviteItemRequest calls the generic PatchOrginviteItem builder with application/json body
+func NewPatchOrginviteItemRequest(server string, orginviteId OrginviteId, params *PatchOrginviteItemParams, body PatchOrginviteItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchOrginviteItemRequestWithBody(server, orginviteId, params, "application/json", bodyReader)
+}
+
+// NewPatchOrginviteItemRequestWithBody generates requests for PatchOrginviteItem with any type of body
+func NewPatchOrginviteItemRequestWithBody(server string, orginviteId OrginviteId, params *PatchOrginviteItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orginviteId", runtime.ParamLocationPath, orginviteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orginvites/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewPutOrginviteItemRequest calls the generic PutOrginviteItem builder with application/json body
+func NewPutOrginviteItemRequest(server string, orginviteId OrginviteId, params *PutOrginviteItemParams, body PutOrginviteItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutOrginviteItemRequestWithBody(server, orginviteId, params, "application/json", bodyReader)
+}
+
+// NewPutOrginviteItemRequestWithBody generates requests for PutOrginviteItem with any type of body
+func NewPutOrginviteItemRequestWithBody(server string, orginviteId OrginviteId, params *PutOrginviteItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orginviteId", runtime.ParamLocationPath, orginviteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orginvites/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetv1orgsRequest generates requests for Getv1orgs
+func NewGetv1orgsRequest(server string, params *Getv1orgsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orgs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Where != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Projection != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxResults != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgItemRequest generates requests for GetOrgItem
+func NewGetOrgItemRequest(server string, orgId OrgId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orgs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchOrgItemRequest calls the generic PatchOrgItem builder with application/json body
+func NewPatchOrgItemRequest(server string, orgId OrgId, params *PatchOrgItemParams, body PatchOrgItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchOrgItemRequestWithBody(server, orgId, params, "application/json", bodyReader)
+}
+
+// NewPatchOrgItemRequestWithBody generates requests for PatchOrgItem with any type of body
+func NewPatchOrgItemRequestWithBody(server string, orgId OrgId, params *PatchOrgItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orgs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewPutOrgItemRequest calls the generic PutOrgItem builder with application/json body
+func NewPutOrgItemRequest(server string, orgId OrgId, params *PutOrgItemParams, body PutOrgItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutOrgItemRequestWithBody(server, orgId, params, "application/json", bodyReader)
+}
+
+// NewPutOrgItemRequestWithBody generates requests for PutOrgItem with any type of body
+func NewPutOrgItemRequestWithBody(server string, orgId OrgId, params *PutOrgItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgId", runtime.ParamLocationPath, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/orgs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetv1policiesRequest generates requests for Getv1policies
+func NewGetv1policiesRequest(server string, params *Getv1policiesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Where != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Projection != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxResults != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostv1policiesRequest calls the generic Postv1policies builder with application/json body
+func NewPostv1policiesRequest(server string, body Postv1policiesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostv1policiesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostv1policiesRequestWithBody generates requests for Postv1policies with any type of body
+func NewPostv1policiesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletepolicyItemRequest generates requests for DeletepolicyItem
+func NewDeletepolicyItemRequest(server string, policyId PolicyId, params *DeletepolicyItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policyId", runtime.ParamLocationPath, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetpolicyItemRequest generates requests for GetpolicyItem
+func NewGetpolicyItemRequest(server string, policyId PolicyId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policyId", runtime.ParamLocationPath, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchpolicyItemRequest calls the generic PatchpolicyItem builder with application/json body
+func NewPatchpolicyItemRequest(server string, policyId PolicyId, params *PatchpolicyItemParams, body PatchpolicyItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchpolicyItemRequestWithBody(server, policyId, params, "application/json", bodyReader)
+}
+
+// NewPatchpolicyItemRequestWithBody generates requests for PatchpolicyItem with any type of body
+func NewPatchpolicyItemRequestWithBody(server string, policyId PolicyId, params *PatchpolicyItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policyId", runtime.ParamLocationPath, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewPutpolicyItemRequest calls the generic PutpolicyItem builder with application/json body
+func NewPutpolicyItemRequest(server string, policyId PolicyId, params *PutpolicyItemParams, body PutpolicyItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutpolicyItemRequestWithBody(server, policyId, params, "application/json", bodyReader)
+}
+
+// NewPutpolicyItemRequestWithBody generates requests for PutpolicyItem with any type of body
+func NewPutpolicyItemRequestWithBody(server string, policyId PolicyId, params *PutpolicyItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "policyId", runtime.ParamLocationPath, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetv1runkubebackupRequest generates requests for Getv1runkubebackup
+func NewGetv1runkubebackupRequest(server string, params *Getv1runkubebackupParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/runkubebackup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Where != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Projection != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxResults != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostv1runkubebackupRequest calls the generic Postv1runkubebackup builder with application/json body
+func NewPostv1runkubebackupRequest(server string, body Postv1runkubebackupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostv1runkubebackupRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostv1runkubebackupRequestWithBody generates requests for Postv1runkubebackup with any type of body
+func NewPostv1runkubebackupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/runkubebackup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRunkubebackupItemRequest generates requests for DeleteRunkubebackupItem
+func NewDeleteRunkubebackupItemRequest(server string, runkubebackupId RunkubebackupId, params *DeleteRunkubebackupItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "runkubebackupId", runtime.ParamLocationPath, runkubebackupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/runkubebackup/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetRunkubebackupItemRequest generates requests for GetRunkubebackupItem
+func NewGetRunkubebackupItemRequest(server string, runkubebackupId RunkubebackupId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "runkubebackupId", runtime.ParamLocationPath, runkubebackupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/runkubebackup/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchRunkubebackupItemRequest calls the generic PatchRunkubebackupItem builder with application/json body
+func NewPatchRunkubebackupItemRequest(server string, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, body PatchRunkubebackupItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchRunkubebackupItemRequestWithBody(server, runkubebackupId, params, "application/json", bodyReader)
+}
+
+// NewPatchRunkubebackupItemRequestWithBody generates requests for PatchRunkubebackupItem with any type of body
+func NewPatchRunkubebackupItemRequestWithBody(server string, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "runkubebackupId", runtime.ParamLocationPath, runkubebackupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/runkubebackup/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewPutRunkubebackupItemRequest calls the generic PutRunkubebackupItem builder with application/json body
+func NewPutRunkubebackupItemRequest(server string, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, body PutRunkubebackupItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutRunkubebackupItemRequestWithBody(server, runkubebackupId, params, "application/json", bodyReader)
+}
+
+// NewPutRunkubebackupItemRequestWithBody generates requests for PutRunkubebackupItem with any type of body
+func NewPutRunkubebackupItemRequestWithBody(server string, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "runkubebackupId", runtime.ParamLocationPath, runkubebackupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/runkubebackup/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetv1usergroupsRequest generates requests for Getv1usergroups
+func NewGetv1usergroupsRequest(server string, params *Getv1usergroupsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/usergroups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Where != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Projection != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxResults != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostv1usergroupsRequest calls the generic Postv1usergroups builder with application/json body
+func NewPostv1usergroupsRequest(server string, body Postv1usergroupsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostv1usergroupsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostv1usergroupsRequestWithBody generates requests for Postv1usergroups with any type of body
+func NewPostv1usergroupsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/usergroups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUsergroupItemRequest generates requests for DeleteUsergroupItem
+func NewDeleteUsergroupItemRequest(server string, usergroupId UsergroupId, params *DeleteUsergroupItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/usergroups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetUsergroupItemRequest generates requests for GetUsergroupItem
+func NewGetUsergroupItemRequest(server string, usergroupId UsergroupId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/usergroups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchUsergroupItemRequest calls the generic PatchUsergroupItem builder with application/json body
+func NewPatchUsergroupItemRequest(server string, usergroupId UsergroupId, params *PatchUsergroupItemParams, body PatchUsergroupItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchUsergroupItemRequestWithBody(server, usergroupId, params, "application/json", bodyReader)
+}
+
+// NewPatchUsergroupItemRequestWithBody generates requests for PatchUsergroupItem with any type of body
+func NewPatchUsergroupItemRequestWithBody(server string, usergroupId UsergroupId, params *PatchUsergroupItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/usergroups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewPutUsergroupItemRequest calls the generic PutUsergroupItem builder with application/json body
+func NewPutUsergroupItemRequest(server string, usergroupId UsergroupId, params *PutUsergroupItemParams, body PutUsergroupItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUsergroupItemRequestWithBody(server, usergroupId, params, "application/json", bodyReader)
+}
+
+// NewPutUsergroupItemRequestWithBody generates requests for PutUsergroupItem with any type of body
+func NewPutUsergroupItemRequestWithBody(server string, usergroupId UsergroupId, params *PutUsergroupItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/usergroups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewUpdateUserGroupACLRequest calls the generic UpdateUserGroupACL builder with application/json body
+func NewUpdateUserGroupACLRequest(server string, usergroupId UsergroupId, params *UpdateUserGroupACLParams, body UpdateUserGroupACLJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserGroupACLRequestWithBody(server, usergroupId, params, "application/json", bodyReader)
+}
+
+// NewUpdateUserGroupACLRequestWithBody generates requests for UpdateUserGroupACL with any type of body
+func NewUpdateUserGroupACLRequestWithBody(server string, usergroupId UsergroupId, params *UpdateUserGroupACLParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "usergroupId", runtime.ParamLocationPath, usergroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/usergroups/%s/action/update-acls", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewGetv1usersRequest generates requests for Getv1users
+func NewGetv1usersRequest(server string, params *Getv1usersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Where != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "where", runtime.ParamLocationQuery, *params.Where); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Projection != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projection", runtime.ParamLocationQuery, *params.Projection); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.MaxResults != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_results", runtime.ParamLocationQuery, *params.MaxResults); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserItemRequest generates requests for GetUserItem
+func NewGetUserItemRequest(server string, userId UserId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchUserItemRequest calls the generic PatchUserItem builder with application/json body
+func NewPatchUserItemRequest(server string, userId UserId, params *PatchUserItemParams, body PatchUserItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchUserItemRequestWithBody(server, userId, params, "application/json", bodyReader)
+}
+
+// NewPatchUserItemRequestWithBody generates requests for PatchUserItem with any type of body
+func NewPatchUserItemRequestWithBody(server string, userId UserId, params *PatchUserItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+// NewPutUserItemRequest calls the generic PutUserItem builder with application/json body
+func NewPutUserItemRequest(server string, userId UserId, params *PutUserItemParams, body PutUserItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUserItemRequestWithBody(server, userId, params, "application/json", bodyReader)
+}
+
+// NewPutUserItemRequestWithBody generates requests for PutUserItem with any type of body
+func NewPutUserItemRequestWithBody(server string, userId UserId, params *PutUserItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("If-Match", headerParam0)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// Getv1backupinstances request
+	Getv1backupinstancesWithResponse(ctx context.Context, params *Getv1backupinstancesParams, reqEditors ...RequestEditorFn) (*Getv1backupinstancesResponse, error)
+
+	// Postv1backupinstances request with any body
+	Postv1backupinstancesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1backupinstancesResponse, error)
+
+	Postv1backupinstancesWithResponse(ctx context.Context, body Postv1backupinstancesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1backupinstancesResponse, error)
+
+	// DeleteBackupinstanceItem request
+	DeleteBackupinstanceItemWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *DeleteBackupinstanceItemParams, reqEditors ...RequestEditorFn) (*DeleteBackupinstanceItemResponse, error)
+
+	// GetBackupinstanceItem request
+	GetBackupinstanceItemWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, reqEditors ...RequestEditorFn) (*GetBackupinstanceItemResponse, error)
+
+	// PatchBackupinstanceItem request with any body
+	PatchBackupinstanceItemWithBodyWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupinstanceItemResponse, error)
+
+	PatchBackupinstanceItemWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, body PatchBackupinstanceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupinstanceItemResponse, error)
+
+	// PutBackupinstanceItem request with any body
+	PutBackupinstanceItemWithBodyWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBackupinstanceItemResponse, error)
+
+	PutBackupinstanceItemWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, body PutBackupinstanceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBackupinstanceItemResponse, error)
+
+	// Getv1internalacls request
+	Getv1internalaclsWithResponse(ctx context.Context, params *Getv1internalaclsParams, reqEditors ...RequestEditorFn) (*Getv1internalaclsResponse, error)
+
+	// Postv1internalacls request with any body
+	Postv1internalaclsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1internalaclsResponse, error)
+
+	Postv1internalaclsWithResponse(ctx context.Context, body Postv1internalaclsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1internalaclsResponse, error)
+
+	// DeleteInternalaclItem request
+	DeleteInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *DeleteInternalaclItemParams, reqEditors ...RequestEditorFn) (*DeleteInternalaclItemResponse, error)
+
+	// GetInternalaclItem request
+	GetInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, reqEditors ...RequestEditorFn) (*GetInternalaclItemResponse, error)
+
+	// PatchInternalaclItem request with any body
+	PatchInternalaclItemWithBodyWithResponse(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchInternalaclItemResponse, error)
+
+	PatchInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, body PatchInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchInternalaclItemResponse, error)
+
+	// PutInternalaclItem request with any body
+	PutInternalaclItemWithBodyWithResponse(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutInternalaclItemResponse, error)
+
+	PutInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, body PutInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutInternalaclItemResponse, error)
+
+	// Deletev1jobs request
+	Deletev1jobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1jobsResponse, error)
+
+	// Getv1jobs request
+	Getv1jobsWithResponse(ctx context.Context, params *Getv1jobsParams, reqEditors ...RequestEditorFn) (*Getv1jobsResponse, error)
+
+	// Postv1jobs request with any body
+	Postv1jobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1jobsResponse, error)
+
+	Postv1jobsWithResponse(ctx context.Context, body Postv1jobsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1jobsResponse, error)
+
+	// DeleteJobItem request
+	DeleteJobItemWithResponse(ctx context.Context, jobId JobId, params *DeleteJobItemParams, reqEditors ...RequestEditorFn) (*DeleteJobItemResponse, error)
+
+	// GetJobItem request
+	GetJobItemWithResponse(ctx context.Context, jobId JobId, reqEditors ...RequestEditorFn) (*GetJobItemResponse, error)
+
+	// PatchJobItem request with any body
+	PatchJobItemWithBodyWithResponse(ctx context.Context, jobId JobId, params *PatchJobItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchJobItemResponse, error)
+
+	PatchJobItemWithResponse(ctx context.Context, jobId JobId, params *PatchJobItemParams, body PatchJobItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchJobItemResponse, error)
+
+	// PutJobItem request with any body
+	PutJobItemWithBodyWithResponse(ctx context.Context, jobId JobId, params *PutJobItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutJobItemResponse, error)
+
+	PutJobItemWithResponse(ctx context.Context, jobId JobId, params *PutJobItemParams, body PutJobItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutJobItemResponse, error)
+
+	// Getv1kubebackups request
+	Getv1kubebackupsWithResponse(ctx context.Context, params *Getv1kubebackupsParams, reqEditors ...RequestEditorFn) (*Getv1kubebackupsResponse, error)
+
+	// Postv1kubebackups request with any body
+	Postv1kubebackupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kubebackupsResponse, error)
+
+	Postv1kubebackupsWithResponse(ctx context.Context, body Postv1kubebackupsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kubebackupsResponse, error)
+
+	// DeleteKubebackupItem request
+	DeleteKubebackupItemWithResponse(ctx context.Context, kubebackupId KubebackupId, params *DeleteKubebackupItemParams, reqEditors ...RequestEditorFn) (*DeleteKubebackupItemResponse, error)
+
+	// GetKubebackupItem request
+	GetKubebackupItemWithResponse(ctx context.Context, kubebackupId KubebackupId, reqEditors ...RequestEditorFn) (*GetKubebackupItemResponse, error)
+
+	// PatchKubebackupItem request with any body
+	PatchKubebackupItemWithBodyWithResponse(ctx context.Context, kubebackupId KubebackupId, params *PatchKubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKubebackupItemResponse, error)
+
+	PatchKubebackupItemWithResponse(ctx context.Context, kubebackupId KubebackupId, params *PatchKubebackupItemParams, body PatchKubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKubebackupItemResponse, error)
+
+	// PutKubebackupItem request with any body
+	PutKubebackupItemWithBodyWithResponse(ctx context.Context, kubebackupId KubebackupId, params *PutKubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKubebackupItemResponse, error)
+
+	PutKubebackupItemWithResponse(ctx context.Context, kubebackupId KubebackupId, params *PutKubebackupItemParams, body PutKubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKubebackupItemResponse, error)
+
+	// Getv1kubeclusters request
+	Getv1kubeclustersWithResponse(ctx context.Context, params *Getv1kubeclustersParams, reqEditors ...RequestEditorFn) (*Getv1kubeclustersResponse, error)
+
+	// Postv1kubeclusters request with any body
+	Postv1kubeclustersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kubeclustersResponse, error)
+
+	Postv1kubeclustersWithResponse(ctx context.Context, body Postv1kubeclustersJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kubeclustersResponse, error)
+
+	// DeleteKubeclusterItem request
+	DeleteKubeclusterItemWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *DeleteKubeclusterItemParams, reqEditors ...RequestEditorFn) (*DeleteKubeclusterItemResponse, error)
+
+	// GetKubeclusterItem request
+	GetKubeclusterItemWithResponse(ctx context.Context, kubeclusterId KubeclusterId, reqEditors ...RequestEditorFn) (*GetKubeclusterItemResponse, error)
+
+	// PatchKubeclusterItem request with any body
+	PatchKubeclusterItemWithBodyWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKubeclusterItemResponse, error)
+
+	PatchKubeclusterItemWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, body PatchKubeclusterItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKubeclusterItemResponse, error)
+
+	// PutKubeclusterItem request with any body
+	PutKubeclusterItemWithBodyWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKubeclusterItemResponse, error)
+
+	PutKubeclusterItemWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, body PutKubeclusterItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKubeclusterItemResponse, error)
+
+	// Deletev1kubenamespaces request
+	Deletev1kubenamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1kubenamespacesResponse, error)
+
+	// Getv1kubenamespaces request
+	Getv1kubenamespacesWithResponse(ctx context.Context, params *Getv1kubenamespacesParams, reqEditors ...RequestEditorFn) (*Getv1kubenamespacesResponse, error)
+
+	// Postv1kubenamespaces request with any body
+	Postv1kubenamespacesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kubenamespacesResponse, error)
+
+	Postv1kubenamespacesWithResponse(ctx context.Context, body Postv1kubenamespacesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kubenamespacesResponse, error)
+
+	// DeleteKubenamespaceItem request
+	DeleteKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *DeleteKubenamespaceItemParams, reqEditors ...RequestEditorFn) (*DeleteKubenamespaceItemResponse, error)
+
+	// GetKubenamespaceItem request
+	GetKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, reqEditors ...RequestEditorFn) (*GetKubenamespaceItemResponse, error)
+
+	// PatchKubenamespaceItem request with any body
+	PatchKubenamespaceItemWithBodyWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKubenamespaceItemResponse, error)
+
+	PatchKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, body PatchKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKubenamespaceItemResponse, error)
+
+	// PutKubenamespaceItem request with any body
+	PutKubenamespaceItemWithBodyWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKubenamespaceItemResponse, error)
+
+	PutKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, body PutKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKubenamespaceItemResponse, error)
+
+	// Getv1kuberestores request
+	Getv1kuberestoresWithResponse(ctx context.Context, params *Getv1kuberestoresParams, reqEditors ...RequestEditorFn) (*Getv1kuberestoresResponse, error)
+
+	// Postv1kuberestores request with any body
+	Postv1kuberestoresWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kuberestoresResponse, error)
+
+	Postv1kuberestoresWithResponse(ctx context.Context, body Postv1kuberestoresJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kuberestoresResponse, error)
+
+	// DeleteKuberestoreItem request
+	DeleteKuberestoreItemWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *DeleteKuberestoreItemParams, reqEditors ...RequestEditorFn) (*DeleteKuberestoreItemResponse, error)
+
+	// GetKuberestoreItem request
+	GetKuberestoreItemWithResponse(ctx context.Context, kuberestoreId KuberestoreId, reqEditors ...RequestEditorFn) (*GetKuberestoreItemResponse, error)
+
+	// PatchKuberestoreItem request with any body
+	PatchKuberestoreItemWithBodyWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKuberestoreItemResponse, error)
+
+	PatchKuberestoreItemWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, body PatchKuberestoreItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKuberestoreItemResponse, error)
+
+	// PutKuberestoreItem request with any body
+	PutKuberestoreItemWithBodyWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKuberestoreItemResponse, error)
+
+	PutKuberestoreItemWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, body PutKuberestoreItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKuberestoreItemResponse, error)
+
+	// Deletev1orginvites request
+	Deletev1orginvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1orginvitesResponse, error)
+
+	// Getv1orginvites request
+	Getv1orginvitesWithResponse(ctx context.Context, params *Getv1orginvitesParams, reqEditors ...RequestEditorFn) (*Getv1orginvitesResponse, error)
+
+	// Postv1orginvites request with any body
+	Postv1orginvitesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1orginvitesResponse, error)
+
+	Postv1orginvitesWithResponse(ctx context.Context, body Postv1orginvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1orginvitesResponse, error)
+
+	// DeleteOrginviteItem request
+	DeleteOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *DeleteOrginviteItemParams, reqEditors ...RequestEditorFn) (*DeleteOrginviteItemResponse, error)
+
+	// GetOrginviteItem request
+	GetOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, reqEditors ...RequestEditorFn) (*GetOrginviteItemResponse, error)
+
+	// PatchOrginviteItem request with any body
+	PatchOrginviteItemWithBodyWithResponse(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrginviteItemResponse, error)
+
+	PatchOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, body PatchOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrginviteItemResponse, error)
+
+	// PutOrginviteItem request with any body
+	PutOrginviteItemWithBodyWithResponse(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrginviteItemResponse, error)
+
+	PutOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, body PutOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrginviteItemResponse, error)
+
+	// Getv1orgs request
+	Getv1orgsWithResponse(ctx context.Context, params *Getv1orgsParams, reqEditors ...RequestEditorFn) (*Getv1orgsResponse, error)
+
+	// GetOrgItem request
+	GetOrgItemWithResponse(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*GetOrgItemResponse, error)
+
+	// PatchOrgItem request with any body
+	PatchOrgItemWithBodyWithResponse(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrgItemResponse, error)
+
+	PatchOrgItemWithResponse(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, body PatchOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrgItemResponse, error)
+
+	// PutOrgItem request with any body
+	PutOrgItemWithBodyWithResponse(ctx context.Context, orgId OrgId, params *PutOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrgItemResponse, error)
+
+	PutOrgItemWithResponse(ctx context.Context, orgId OrgId, params *PutOrgItemParams, body PutOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrgItemResponse, error)
+
+	// Getv1policies request
+	Getv1policiesWithResponse(ctx context.Context, params *Getv1policiesParams, reqEditors ...RequestEditorFn) (*Getv1policiesResponse, error)
+
+	// Postv1policies request with any body
+	Postv1policiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1policiesResponse, error)
+
+	Postv1policiesWithResponse(ctx context.Context, body Postv1policiesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1policiesResponse, error)
+
+	// DeletepolicyItem request
+	DeletepolicyItemWithResponse(ctx context.Context, policyId PolicyId, params *DeletepolicyItemParams, reqEditors ...RequestEditorFn) (*DeletepolicyItemResponse, error)
+
+	// GetpolicyItem request
+	GetpolicyItemWithResponse(ctx context.Context, policyId PolicyId, reqEditors ...RequestEditorFn) (*GetpolicyItemResponse, error)
+
+	// PatchpolicyItem request with any body
+	PatchpolicyItemWithBodyWithResponse(ctx context.Context, policyId PolicyId, params *PatchpolicyItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchpolicyItemResponse, error)
+
+	PatchpolicyItemWithResponse(ctx context.Context, policyId PolicyId, params *PatchpolicyItemParams, body PatchpolicyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchpolicyItemResponse, error)
+
+	// PutpolicyItem request with any body
+	PutpolicyItemWithBodyWithResponse(ctx context.Context, policyId PolicyId, params *PutpolicyItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutpolicyItemResponse, error)
+
+	PutpolicyItemWithResponse(ctx context.Context, policyId PolicyId, params *PutpolicyItemParams, body PutpolicyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutpolicyItemResponse, error)
+
+	// Getv1runkubebackup request
+	Getv1runkubebackupWithResponse(ctx context.Context, params *Getv1runkubebackupParams, reqEditors ...RequestEditorFn) (*Getv1runkubebackupResponse, error)
+
+	// Postv1runkubebackup request with any body
+	Postv1runkubebackupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1runkubebackupResponse, error)
+
+	Postv1runkubebackupWithResponse(ctx context.Context, body Postv1runkubebackupJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1runkubebackupResponse, error)
+
+	// DeleteRunkubebackupItem request
+	DeleteRunkubebackupItemWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *DeleteRunkubebackupItemParams, reqEditors ...RequestEditorFn) (*DeleteRunkubebackupItemResponse, error)
+
+	// GetRunkubebackupItem request
+	GetRunkubebackupItemWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, reqEditors ...RequestEditorFn) (*GetRunkubebackupItemResponse, error)
+
+	// PatchRunkubebackupItem request with any body
+	PatchRunkubebackupItemWithBodyWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchRunkubebackupItemResponse, error)
+
+	PatchRunkubebackupItemWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, body PatchRunkubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchRunkubebackupItemResponse, error)
+
+	// PutRunkubebackupItem request with any body
+	PutRunkubebackupItemWithBodyWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutRunkubebackupItemResponse, error)
+
+	PutRunkubebackupItemWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, body PutRunkubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutRunkubebackupItemResponse, error)
+
+	// Getv1usergroups request
+	Getv1usergroupsWithResponse(ctx context.Context, params *Getv1usergroupsParams, reqEditors ...RequestEditorFn) (*Getv1usergroupsResponse, error)
+
+	// Postv1usergroups request with any body
+	Postv1usergroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1usergroupsResponse, error)
+
+	Postv1usergroupsWithResponse(ctx context.Context, body Postv1usergroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1usergroupsResponse, error)
+
+	// DeleteUsergroupItem request
+	DeleteUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *DeleteUsergroupItemParams, reqEditors ...RequestEditorFn) (*DeleteUsergroupItemResponse, error)
+
+	// GetUsergroupItem request
+	GetUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, reqEditors ...RequestEditorFn) (*GetUsergroupItemResponse, error)
+
+	// PatchUsergroupItem request with any body
+	PatchUsergroupItemWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsergroupItemResponse, error)
+
+	PatchUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, body PatchUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsergroupItemResponse, error)
+
+	// PutUsergroupItem request with any body
+	PutUsergroupItemWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsergroupItemResponse, error)
+
+	PutUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, body PutUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsergroupItemResponse, error)
+
+	// UpdateUserGroupACL request with any body
+	UpdateUserGroupACLWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserGroupACLResponse, error)
+
+	UpdateUserGroupACLWithResponse(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, body UpdateUserGroupACLJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserGroupACLResponse, error)
+
+	// Getv1users request
+	Getv1usersWithResponse(ctx context.Context, params *Getv1usersParams, reqEditors ...RequestEditorFn) (*Getv1usersResponse, error)
+
+	// GetUserItem request
+	GetUserItemWithResponse(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*GetUserItemResponse, error)
+
+	// PatchUserItem request with any body
+	PatchUserItemWithBodyWithResponse(ctx context.Context, userId UserId, params *PatchUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUserItemResponse, error)
+
+	PatchUserItemWithResponse(ctx context.Context, userId UserId, params *PatchUserItemParams, body PatchUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUserItemResponse, error)
+
+	// PutUserItem request with any body
+	PutUserItemWithBodyWithResponse(ctx context.Context, userId UserId, params *PutUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUserItemResponse, error)
+
+	PutUserItemWithResponse(ctx context.Context, userId UserId, params *PutUserItemParams, body PutUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUserItemResponse, error)
+}
+
+type Getv1backupinstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Backupinstance `json:"_items,omitempty"`
+		Links *ResponeLinks     `json:"_links,omitempty"`
+		Meta  *ResponeMetadata  `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1backupinstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1backupinstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1backupinstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1backupinstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1backupinstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBackupinstanceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBackupinstanceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBackupinstanceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBackupinstanceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Backupinstance
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBackupinstanceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBackupinstanceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchBackupinstanceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchBackupinstanceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchBackupinstanceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBackupinstanceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBackupinstanceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBackupinstanceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1internalaclsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Internalacl   `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1internalaclsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1internalaclsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1internalaclsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1internalaclsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1internalaclsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInternalaclItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInternalaclItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInternalaclItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInternalaclItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Internalacl
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInternalaclItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInternalaclItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchInternalaclItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchInternalaclItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchInternalaclItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutInternalaclItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutInternalaclItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutInternalaclItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Deletev1jobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Deletev1jobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Deletev1jobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1jobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Job           `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1jobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1jobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1jobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1jobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1jobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteJobItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteJobItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteJobItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchJobItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchJobItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchJobItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutJobItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutJobItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutJobItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1kubebackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Kubebackup    `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1kubebackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1kubebackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1kubebackupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1kubebackupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1kubebackupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteKubebackupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteKubebackupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteKubebackupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKubebackupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Kubebackup
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKubebackupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKubebackupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchKubebackupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchKubebackupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchKubebackupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutKubebackupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutKubebackupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutKubebackupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1kubeclustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Kubecluster   `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1kubeclustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1kubeclustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1kubeclustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1kubeclustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1kubeclustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteKubeclusterItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteKubeclusterItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteKubeclusterItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKubeclusterItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Kubecluster
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKubeclusterItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKubeclusterItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchKubeclusterItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchKubeclusterItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchKubeclusterItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutKubeclusterItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutKubeclusterItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutKubeclusterItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Deletev1kubenamespacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Deletev1kubenamespacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Deletev1kubenamespacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1kubenamespacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Kubenamespace `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1kubenamespacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1kubenamespacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1kubenamespacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1kubenamespacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1kubenamespacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteKubenamespaceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteKubenamespaceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteKubenamespaceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKubenamespaceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Kubenamespace
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKubenamespaceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKubenamespaceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchKubenamespaceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchKubenamespaceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchKubenamespaceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutKubenamespaceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutKubenamespaceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutKubenamespaceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1kuberestoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Kuberestore   `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1kuberestoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1kuberestoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1kuberestoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1kuberestoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1kuberestoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteKuberestoreItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteKuberestoreItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteKuberestoreItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKuberestoreItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Kuberestore
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKuberestoreItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKuberestoreItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchKuberestoreItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchKuberestoreItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchKuberestoreItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutKuberestoreItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutKuberestoreItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutKuberestoreItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Deletev1orginvitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Deletev1orginvitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Deletev1orginvitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1orginvitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Orginvite     `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1orginvitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1orginvitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1orginvitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1orginvitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1orginvitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOrginviteItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrginviteItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrginviteItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrginviteItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Orginvite
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrginviteItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrginviteItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchOrginviteItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchOrginviteItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchOrginviteItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutOrginviteItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutOrginviteItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutOrginviteItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1orgsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Org           `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1orgsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1orgsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Org
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchOrgItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchOrgItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchOrgItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutOrgItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutOrgItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutOrgItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1policiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Policy        `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1policiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1policiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1policiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1policiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1policiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletepolicyItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletepolicyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletepolicyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetpolicyItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Policy
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetpolicyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetpolicyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchpolicyItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchpolicyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchpolicyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutpolicyItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutpolicyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutpolicyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1runkubebackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Runkubebackup `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1runkubebackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1runkubebackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1runkubebackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1runkubebackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1runkubebackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRunkubebackupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRunkubebackupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRunkubebackupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRunkubebackupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Runkubebackup
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRunkubebackupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRunkubebackupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchRunkubebackupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchRunkubebackupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchRunkubebackupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutRunkubebackupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutRunkubebackupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutRunkubebackupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1usergroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]Usergroup     `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1usergroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1usergroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Postv1usergroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Postv1usergroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Postv1usergroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsergroupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsergroupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsergroupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsergroupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Usergroup
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsergroupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsergroupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchUsergroupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchUsergroupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchUsergroupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUsergroupItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUsergroupItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUsergroupItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserGroupACLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ACLs
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserGroupACLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserGroupACLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Getv1usersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items *[]User          `json:"_items,omitempty"`
+		Links *ResponeLinks    `json:"_links,omitempty"`
+		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r Getv1usersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Getv1usersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserItemResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Items *[]Kubenamespace `json:"_items,omitempty"`
-		Links *ResponeLinks    `json:"_links,omitempty"`
-		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchUserItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchUserItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchUserItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUserItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUserItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUserItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Getv1backupinstancesWithResponse request returning *Getv1backupinstancesResponse
+func (c *ClientWithResponses) Getv1backupinstancesWithResponse(ctx context.Context, params *Getv1backupinstancesParams, reqEditors ...RequestEditorFn) (*Getv1backupinstancesResponse, error) {
+	rsp, err := c.Getv1backupinstances(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1backupinstancesResponse(rsp)
+}
+
+// Postv1backupinstancesWithBodyWithResponse request with arbitrary body returning *Postv1backupinstancesResponse
+func (c *ClientWithResponses) Postv1backupinstancesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1backupinstancesResponse, error) {
+	rsp, err := c.Postv1backupinstancesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1backupinstancesResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1backupinstancesWithResponse(ctx context.Context, body Postv1backupinstancesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1backupinstancesResponse, error) {
+	rsp, err := c.Postv1backupinstances(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1backupinstancesResponse(rsp)
+}
+
+// DeleteBackupinstanceItemWithResponse request returning *DeleteBackupinstanceItemResponse
+func (c *ClientWithResponses) DeleteBackupinstanceItemWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *DeleteBackupinstanceItemParams, reqEditors ...RequestEditorFn) (*DeleteBackupinstanceItemResponse, error) {
+	rsp, err := c.DeleteBackupinstanceItem(ctx, backupinstanceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBackupinstanceItemResponse(rsp)
+}
+
+// GetBackupinstanceItemWithResponse request returning *GetBackupinstanceItemResponse
+func (c *ClientWithResponses) GetBackupinstanceItemWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, reqEditors ...RequestEditorFn) (*GetBackupinstanceItemResponse, error) {
+	rsp, err := c.GetBackupinstanceItem(ctx, backupinstanceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBackupinstanceItemResponse(rsp)
+}
+
+// PatchBackupinstanceItemWithBodyWithResponse request with arbitrary body returning *PatchBackupinstanceItemResponse
+func (c *ClientWithResponses) PatchBackupinstanceItemWithBodyWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchBackupinstanceItemResponse, error) {
+	rsp, err := c.PatchBackupinstanceItemWithBody(ctx, backupinstanceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchBackupinstanceItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchBackupinstanceItemWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *PatchBackupinstanceItemParams, body PatchBackupinstanceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchBackupinstanceItemResponse, error) {
+	rsp, err := c.PatchBackupinstanceItem(ctx, backupinstanceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchBackupinstanceItemResponse(rsp)
+}
+
+// PutBackupinstanceItemWithBodyWithResponse request with arbitrary body returning *PutBackupinstanceItemResponse
+func (c *ClientWithResponses) PutBackupinstanceItemWithBodyWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBackupinstanceItemResponse, error) {
+	rsp, err := c.PutBackupinstanceItemWithBody(ctx, backupinstanceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBackupinstanceItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutBackupinstanceItemWithResponse(ctx context.Context, backupinstanceId BackupinstanceId, params *PutBackupinstanceItemParams, body PutBackupinstanceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBackupinstanceItemResponse, error) {
+	rsp, err := c.PutBackupinstanceItem(ctx, backupinstanceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBackupinstanceItemResponse(rsp)
+}
+
+// Getv1internalaclsWithResponse request returning *Getv1internalaclsResponse
+func (c *ClientWithResponses) Getv1internalaclsWithResponse(ctx context.Context, params *Getv1internalaclsParams, reqEditors ...RequestEditorFn) (*Getv1internalaclsResponse, error) {
+	rsp, err := c.Getv1internalacls(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1internalaclsResponse(rsp)
+}
+
+// Postv1internalaclsWithBodyWithResponse request with arbitrary body returning *Postv1internalaclsResponse
+func (c *ClientWithResponses) Postv1internalaclsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1internalaclsResponse, error) {
+	rsp, err := c.Postv1internalaclsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1internalaclsResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1internalaclsWithResponse(ctx context.Context, body Postv1internalaclsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1internalaclsResponse, error) {
+	rsp, err := c.Postv1internalacls(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1internalaclsResponse(rsp)
+}
+
+// DeleteInternalaclItemWithResponse request returning *DeleteInternalaclItemResponse
+func (c *ClientWithResponses) DeleteInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *DeleteInternalaclItemParams, reqEditors ...RequestEditorFn) (*DeleteInternalaclItemResponse, error) {
+	rsp, err := c.DeleteInternalaclItem(ctx, internalaclId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInternalaclItemResponse(rsp)
+}
+
+// GetInternalaclItemWithResponse request returning *GetInternalaclItemResponse
+func (c *ClientWithResponses) GetInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, reqEditors ...RequestEditorFn) (*GetInternalaclItemResponse, error) {
+	rsp, err := c.GetInternalaclItem(ctx, internalaclId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInternalaclItemResponse(rsp)
+}
+
+// PatchInternalaclItemWithBodyWithResponse request with arbitrary body returning *PatchInternalaclItemResponse
+func (c *ClientWithResponses) PatchInternalaclItemWithBodyWithResponse(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchInternalaclItemResponse, error) {
+	rsp, err := c.PatchInternalaclItemWithBody(ctx, internalaclId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchInternalaclItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, body PatchInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchInternalaclItemResponse, error) {
+	rsp, err := c.PatchInternalaclItem(ctx, internalaclId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchInternalaclItemResponse(rsp)
+}
+
+// PutInternalaclItemWithBodyWithResponse request with arbitrary body returning *PutInternalaclItemResponse
+func (c *ClientWithResponses) PutInternalaclItemWithBodyWithResponse(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutInternalaclItemResponse, error) {
+	rsp, err := c.PutInternalaclItemWithBody(ctx, internalaclId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutInternalaclItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, body PutInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutInternalaclItemResponse, error) {
+	rsp, err := c.PutInternalaclItem(ctx, internalaclId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutInternalaclItemResponse(rsp)
+}
+
+// Deletev1jobsWithResponse request returning *Deletev1jobsResponse
+func (c *ClientWithResponses) Deletev1jobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1jobsResponse, error) {
+	rsp, err := c.Deletev1jobs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletev1jobsResponse(rsp)
+}
+
+// Getv1jobsWithResponse request returning *Getv1jobsResponse
+func (c *ClientWithResponses) Getv1jobsWithResponse(ctx context.Context, params *Getv1jobsParams, reqEditors ...RequestEditorFn) (*Getv1jobsResponse, error) {
+	rsp, err := c.Getv1jobs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1jobsResponse(rsp)
+}
+
+// Postv1jobsWithBodyWithResponse request with arbitrary body returning *Postv1jobsResponse
+func (c *ClientWithResponses) Postv1jobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1jobsResponse, error) {
+	rsp, err := c.Postv1jobsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1jobsResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1jobsWithResponse(ctx context.Context, body Postv1jobsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1jobsResponse, error) {
+	rsp, err := c.Postv1jobs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1jobsResponse(rsp)
+}
+
+// DeleteJobItemWithResponse request returning *DeleteJobItemResponse
+func (c *ClientWithResponses) DeleteJobItemWithResponse(ctx context.Context, jobId JobId, params *DeleteJobItemParams, reqEditors ...RequestEditorFn) (*DeleteJobItemResponse, error) {
+	rsp, err := c.DeleteJobItem(ctx, jobId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteJobItemResponse(rsp)
+}
+
+// GetJobItemWithResponse request returning *GetJobItemResponse
+func (c *ClientWithResponses) GetJobItemWithResponse(ctx context.Context, jobId JobId, reqEditors ...RequestEditorFn) (*GetJobItemResponse, error) {
+	rsp, err := c.GetJobItem(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobItemResponse(rsp)
+}
+
+// PatchJobItemWithBodyWithResponse request with arbitrary body returning *PatchJobItemResponse
+func (c *ClientWithResponses) PatchJobItemWithBodyWithResponse(ctx context.Context, jobId JobId, params *PatchJobItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchJobItemResponse, error) {
+	rsp, err := c.PatchJobItemWithBody(ctx, jobId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchJobItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchJobItemWithResponse(ctx context.Context, jobId JobId, params *PatchJobItemParams, body PatchJobItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchJobItemResponse, error) {
+	rsp, err := c.PatchJobItem(ctx, jobId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchJobItemResponse(rsp)
+}
+
+// PutJobItemWithBodyWithResponse request with arbitrary body returning *PutJobItemResponse
+func (c *ClientWithResponses) PutJobItemWithBodyWithResponse(ctx context.Context, jobId JobId, params *PutJobItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutJobItemResponse, error) {
+	rsp, err := c.PutJobItemWithBody(ctx, jobId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutJobItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutJobItemWithResponse(ctx context.Context, jobId JobId, params *PutJobItemParams, body PutJobItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutJobItemResponse, error) {
+	rsp, err := c.PutJobItem(ctx, jobId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutJobItemResponse(rsp)
+}
+
+// Getv1kubebackupsWithResponse request returning *Getv1kubebackupsResponse
+func (c *ClientWithResponses) Getv1kubebackupsWithResponse(ctx context.Context, params *Getv1kubebackupsParams, reqEditors ...RequestEditorFn) (*Getv1kubebackupsResponse, error) {
+	rsp, err := c.Getv1kubebackups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1kubebackupsResponse(rsp)
+}
+
+// Postv1kubebackupsWithBodyWithResponse request with arbitrary body returning *Postv1kubebackupsResponse
+func (c *ClientWithResponses) Postv1kubebackupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kubebackupsResponse, error) {
+	rsp, err := c.Postv1kubebackupsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1kubebackupsResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1kubebackupsWithResponse(ctx context.Context, body Postv1kubebackupsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kubebackupsResponse, error) {
+	rsp, err := c.Postv1kubebackups(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1kubebackupsResponse(rsp)
+}
+
+// DeleteKubebackupItemWithResponse request returning *DeleteKubebackupItemResponse
+func (c *ClientWithResponses) DeleteKubebackupItemWithResponse(ctx context.Context, kubebackupId KubebackupId, params *DeleteKubebackupItemParams, reqEditors ...RequestEditorFn) (*DeleteKubebackupItemResponse, error) {
+	rsp, err := c.DeleteKubebackupItem(ctx, kubebackupId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteKubebackupItemResponse(rsp)
+}
+
+// GetKubebackupItemWithResponse request returning *GetKubebackupItemResponse
+func (c *ClientWithResponses) GetKubebackupItemWithResponse(ctx context.Context, kubebackupId KubebackupId, reqEditors ...RequestEditorFn) (*GetKubebackupItemResponse, error) {
+	rsp, err := c.GetKubebackupItem(ctx, kubebackupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKubebackupItemResponse(rsp)
+}
+
+// PatchKubebackupItemWithBodyWithResponse request with arbitrary body returning *PatchKubebackupItemResponse
+func (c *ClientWithResponses) PatchKubebackupItemWithBodyWithResponse(ctx context.Context, kubebackupId KubebackupId, params *PatchKubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKubebackupItemResponse, error) {
+	rsp, err := c.PatchKubebackupItemWithBody(ctx, kubebackupId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchKubebackupItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchKubebackupItemWithResponse(ctx context.Context, kubebackupId KubebackupId, params *PatchKubebackupItemParams, body PatchKubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKubebackupItemResponse, error) {
+	rsp, err := c.PatchKubebackupItem(ctx, kubebackupId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchKubebackupItemResponse(rsp)
+}
+
+// PutKubebackupItemWithBodyWithResponse request with arbitrary body returning *PutKubebackupItemResponse
+func (c *ClientWithResponses) PutKubebackupItemWithBodyWithResponse(ctx context.Context, kubebackupId KubebackupId, params *PutKubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKubebackupItemResponse, error) {
+	rsp, err := c.PutKubebackupItemWithBody(ctx, kubebackupId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutKubebackupItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutKubebackupItemWithResponse(ctx context.Context, kubebackupId KubebackupId, params *PutKubebackupItemParams, body PutKubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKubebackupItemResponse, error) {
+	rsp, err := c.PutKubebackupItem(ctx, kubebackupId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutKubebackupItemResponse(rsp)
+}
+
+// Getv1kubeclustersWithResponse request returning *Getv1kubeclustersResponse
+func (c *ClientWithResponses) Getv1kubeclustersWithResponse(ctx context.Context, params *Getv1kubeclustersParams, reqEditors ...RequestEditorFn) (*Getv1kubeclustersResponse, error) {
+	rsp, err := c.Getv1kubeclusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1kubeclustersResponse(rsp)
+}
+
+// Postv1kubeclustersWithBodyWithResponse request with arbitrary body returning *Postv1kubeclustersResponse
+func (c *ClientWithResponses) Postv1kubeclustersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kubeclustersResponse, error) {
+	rsp, err := c.Postv1kubeclustersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1kubeclustersResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1kubeclustersWithResponse(ctx context.Context, body Postv1kubeclustersJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kubeclustersResponse, error) {
+	rsp, err := c.Postv1kubeclusters(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1kubeclustersResponse(rsp)
+}
+
+// DeleteKubeclusterItemWithResponse request returning *DeleteKubeclusterItemResponse
+func (c *ClientWithResponses) DeleteKubeclusterItemWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *DeleteKubeclusterItemParams, reqEditors ...RequestEditorFn) (*DeleteKubeclusterItemResponse, error) {
+	rsp, err := c.DeleteKubeclusterItem(ctx, kubeclusterId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteKubeclusterItemResponse(rsp)
+}
+
+// GetKubeclusterItemWithResponse request returning *GetKubeclusterItemResponse
+func (c *ClientWithResponses) GetKubeclusterItemWithResponse(ctx context.Context, kubeclusterId KubeclusterId, reqEditors ...RequestEditorFn) (*GetKubeclusterItemResponse, error) {
+	rsp, err := c.GetKubeclusterItem(ctx, kubeclusterId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKubeclusterItemResponse(rsp)
+}
+
+// PatchKubeclusterItemWithBodyWithResponse request with arbitrary body returning *PatchKubeclusterItemResponse
+func (c *ClientWithResponses) PatchKubeclusterItemWithBodyWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKubeclusterItemResponse, error) {
+	rsp, err := c.PatchKubeclusterItemWithBody(ctx, kubeclusterId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchKubeclusterItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchKubeclusterItemWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *PatchKubeclusterItemParams, body PatchKubeclusterItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKubeclusterItemResponse, error) {
+	rsp, err := c.PatchKubeclusterItem(ctx, kubeclusterId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchKubeclusterItemResponse(rsp)
+}
+
+// PutKubeclusterItemWithBodyWithResponse request with arbitrary body returning *PutKubeclusterItemResponse
+func (c *ClientWithResponses) PutKubeclusterItemWithBodyWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKubeclusterItemResponse, error) {
+	rsp, err := c.PutKubeclusterItemWithBody(ctx, kubeclusterId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutKubeclusterItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutKubeclusterItemWithResponse(ctx context.Context, kubeclusterId KubeclusterId, params *PutKubeclusterItemParams, body PutKubeclusterItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKubeclusterItemResponse, error) {
+	rsp, err := c.PutKubeclusterItem(ctx, kubeclusterId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutKubeclusterItemResponse(rsp)
+}
+
+// Deletev1kubenamespacesWithResponse request returning *Deletev1kubenamespacesResponse
+func (c *ClientWithResponses) Deletev1kubenamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1kubenamespacesResponse, error) {
+	rsp, err := c.Deletev1kubenamespaces(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletev1kubenamespacesResponse(rsp)
+}
+
+// Getv1kubenamespacesWithResponse request returning *Getv1kubenamespacesResponse
+func (c *ClientWithResponses) Getv1kubenamespacesWithResponse(ctx context.Context, params *Getv1kubenamespacesParams, reqEditors ...RequestEditorFn) (*Getv1kubenamespacesResponse, error) {
+	rsp, err := c.Getv1kubenamespaces(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1kubenamespacesResponse(rsp)
+}
+
+// Postv1kubenamespacesWithBodyWithResponse request with arbitrary body returning *Postv1kubenamespacesResponse
+func (c *ClientWithResponses) Postv1kubenamespacesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kubenamespacesResponse, error) {
+	rsp, err := c.Postv1kubenamespacesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1kubenamespacesResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1kubenamespacesWithResponse(ctx context.Context, body Postv1kubenamespacesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kubenamespacesResponse, error) {
+	rsp, err := c.Postv1kubenamespaces(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1kubenamespacesResponse(rsp)
+}
+
+// DeleteKubenamespaceItemWithResponse request returning *DeleteKubenamespaceItemResponse
+func (c *ClientWithResponses) DeleteKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *DeleteKubenamespaceItemParams, reqEditors ...RequestEditorFn) (*DeleteKubenamespaceItemResponse, error) {
+	rsp, err := c.DeleteKubenamespaceItem(ctx, kubenamespaceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteKubenamespaceItemResponse(rsp)
+}
+
+// GetKubenamespaceItemWithResponse request returning *GetKubenamespaceItemResponse
+func (c *ClientWithResponses) GetKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, reqEditors ...RequestEditorFn) (*GetKubenamespaceItemResponse, error) {
+	rsp, err := c.GetKubenamespaceItem(ctx, kubenamespaceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKubenamespaceItemResponse(rsp)
+}
+
+// PatchKubenamespaceItemWithBodyWithResponse request with arbitrary body returning *PatchKubenamespaceItemResponse
+func (c *ClientWithResponses) PatchKubenamespaceItemWithBodyWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKubenamespaceItemResponse, error) {
+	rsp, err := c.PatchKubenamespaceItemWithBody(ctx, kubenamespaceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchKubenamespaceItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, body PatchKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKubenamespaceItemResponse, error) {
+	rsp, err := c.PatchKubenamespaceItem(ctx, kubenamespaceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchKubenamespaceItemResponse(rsp)
+}
+
+// PutKubenamespaceItemWithBodyWithResponse request with arbitrary body returning *PutKubenamespaceItemResponse
+func (c *ClientWithResponses) PutKubenamespaceItemWithBodyWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKubenamespaceItemResponse, error) {
+	rsp, err := c.PutKubenamespaceItemWithBody(ctx, kubenamespaceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutKubenamespaceItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, body PutKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKubenamespaceItemResponse, error) {
+	rsp, err := c.PutKubenamespaceItem(ctx, kubenamespaceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutKubenamespaceItemResponse(rsp)
+}
+
+// Getv1kuberestoresWithResponse request returning *Getv1kuberestoresResponse
+func (c *ClientWithResponses) Getv1kuberestoresWithResponse(ctx context.Context, params *Getv1kuberestoresParams, reqEditors ...RequestEditorFn) (*Getv1kuberestoresResponse, error) {
+	rsp, err := c.Getv1kuberestores(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1kuberestoresResponse(rsp)
+}
+
+// Postv1kuberestoresWithBodyWithResponse request with arbitrary body returning *Postv1kuberestoresResponse
+func (c *ClientWithResponses) Postv1kuberestoresWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kuberestoresResponse, error) {
+	rsp, err := c.Postv1kuberestoresWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1kuberestoresResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1kuberestoresWithResponse(ctx context.Context, body Postv1kuberestoresJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kuberestoresResponse, error) {
+	rsp, err := c.Postv1kuberestores(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1kuberestoresResponse(rsp)
+}
+
+// DeleteKuberestoreItemWithResponse request returning *DeleteKuberestoreItemResponse
+func (c *ClientWithResponses) DeleteKuberestoreItemWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *DeleteKuberestoreItemParams, reqEditors ...RequestEditorFn) (*DeleteKuberestoreItemResponse, error) {
+	rsp, err := c.DeleteKuberestoreItem(ctx, kuberestoreId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteKuberestoreItemResponse(rsp)
+}
+
+// GetKuberestoreItemWithResponse request returning *GetKuberestoreItemResponse
+func (c *ClientWithResponses) GetKuberestoreItemWithResponse(ctx context.Context, kuberestoreId KuberestoreId, reqEditors ...RequestEditorFn) (*GetKuberestoreItemResponse, error) {
+	rsp, err := c.GetKuberestoreItem(ctx, kuberestoreId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKuberestoreItemResponse(rsp)
+}
+
+// PatchKuberestoreItemWithBodyWithResponse request with arbitrary body returning *PatchKuberestoreItemResponse
+func (c *ClientWithResponses) PatchKuberestoreItemWithBodyWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKuberestoreItemResponse, error) {
+	rsp, err := c.PatchKuberestoreItemWithBody(ctx, kuberestoreId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchKuberestoreItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchKuberestoreItemWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *PatchKuberestoreItemParams, body PatchKuberestoreItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKuberestoreItemResponse, error) {
+	rsp, err := c.PatchKuberestoreItem(ctx, kuberestoreId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchKuberestoreItemResponse(rsp)
+}
+
+// PutKuberestoreItemWithBodyWithResponse request with arbitrary body returning *PutKuberestoreItemResponse
+func (c *ClientWithResponses) PutKuberestoreItemWithBodyWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKuberestoreItemResponse, error) {
+	rsp, err := c.PutKuberestoreItemWithBody(ctx, kuberestoreId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutKuberestoreItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutKuberestoreItemWithResponse(ctx context.Context, kuberestoreId KuberestoreId, params *PutKuberestoreItemParams, body PutKuberestoreItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKuberestoreItemResponse, error) {
+	rsp, err := c.PutKuberestoreItem(ctx, kuberestoreId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutKuberestoreItemResponse(rsp)
+}
+
+// Deletev1orginvitesWithResponse request returning *Deletev1orginvitesResponse
+func (c *ClientWithResponses) Deletev1orginvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1orginvitesResponse, error) {
+	rsp, err := c.Deletev1orginvites(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletev1orginvitesResponse(rsp)
+}
+
+// Getv1orginvitesWithResponse request returning *Getv1orginvitesResponse
+func (c *ClientWithResponses) Getv1orginvitesWithResponse(ctx context.Context, params *Getv1orginvitesParams, reqEditors ...RequestEditorFn) (*Getv1orginvitesResponse, error) {
+	rsp, err := c.Getv1orginvites(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1orginvitesResponse(rsp)
+}
+
+// Postv1orginvitesWithBodyWithResponse request with arbitrary body returning *Postv1orginvitesResponse
+func (c *ClientWithResponses) Postv1orginvitesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1orginvitesResponse, error) {
+	rsp, err := c.Postv1orginvitesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1orginvitesResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1orginvitesWithResponse(ctx context.Context, body Postv1orginvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1orginvitesResponse, error) {
+	rsp, err := c.Postv1orginvites(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1orginvitesResponse(rsp)
+}
+
+// DeleteOrginviteItemWithResponse request returning *DeleteOrginviteItemResponse
+func (c *ClientWithResponses) DeleteOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *DeleteOrginviteItemParams, reqEditors ...RequestEditorFn) (*DeleteOrginviteItemResponse, error) {
+	rsp, err := c.DeleteOrginviteItem(ctx, orginviteId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrginviteItemResponse(rsp)
+}
+
+// GetOrginviteItemWithResponse request returning *GetOrginviteItemResponse
+func (c *ClientWithResponses) GetOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, reqEditors ...RequestEditorFn) (*GetOrginviteItemResponse, error) {
+	rsp, err := c.GetOrginviteItem(ctx, orginviteId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrginviteItemResponse(rsp)
+}
+
+// PatchOrginviteItemWithBodyWithResponse request with arbitrary body returning *PatchOrginviteItemResponse
+func (c *ClientWithResponses) PatchOrginviteItemWithBodyWithResponse(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrginviteItemResponse, error) {
+	rsp, err := c.PatchOrginviteItemWithBody(ctx, orginviteId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchOrginviteItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, body PatchOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrginviteItemResponse, error) {
+	rsp, err := c.PatchOrginviteItem(ctx, orginviteId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchOrginviteItemResponse(rsp)
+}
+
+// PutOrginviteItemWithBodyWithResponse request with arbitrary body returning *PutOrginviteItemResponse
+func (c *ClientWithResponses) PutOrginviteItemWithBodyWithResponse(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrginviteItemResponse, error) {
+	rsp, err := c.PutOrginviteItemWithBody(ctx, orginviteId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrginviteItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, body PutOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrginviteItemResponse, error) {
+	rsp, err := c.PutOrginviteItem(ctx, orginviteId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrginviteItemResponse(rsp)
+}
+
+// Getv1orgsWithResponse request returning *Getv1orgsResponse
+func (c *ClientWithResponses) Getv1orgsWithResponse(ctx context.Context, params *Getv1orgsParams, reqEditors ...RequestEditorFn) (*Getv1orgsResponse, error) {
+	rsp, err := c.Getv1orgs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1orgsResponse(rsp)
+}
+
+// GetOrgItemWithResponse request returning *GetOrgItemResponse
+func (c *ClientWithResponses) GetOrgItemWithResponse(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*GetOrgItemResponse, error) {
+	rsp, err := c.GetOrgItem(ctx, orgId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgItemResponse(rsp)
+}
+
+// PatchOrgItemWithBodyWithResponse request with arbitrary body returning *PatchOrgItemResponse
+func (c *ClientWithResponses) PatchOrgItemWithBodyWithResponse(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrgItemResponse, error) {
+	rsp, err := c.PatchOrgItemWithBody(ctx, orgId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchOrgItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchOrgItemWithResponse(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, body PatchOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrgItemResponse, error) {
+	rsp, err := c.PatchOrgItem(ctx, orgId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchOrgItemResponse(rsp)
+}
+
+// PutOrgItemWithBodyWithResponse request with arbitrary body returning *PutOrgItemResponse
+func (c *ClientWithResponses) PutOrgItemWithBodyWithResponse(ctx context.Context, orgId OrgId, params *PutOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrgItemResponse, error) {
+	rsp, err := c.PutOrgItemWithBody(ctx, orgId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrgItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutOrgItemWithResponse(ctx context.Context, orgId OrgId, params *PutOrgItemParams, body PutOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrgItemResponse, error) {
+	rsp, err := c.PutOrgItem(ctx, orgId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrgItemResponse(rsp)
+}
+
+// Getv1policiesWithResponse request returning *Getv1policiesResponse
+func (c *ClientWithResponses) Getv1policiesWithResponse(ctx context.Context, params *Getv1policiesParams, reqEditors ...RequestEditorFn) (*Getv1policiesResponse, error) {
+	rsp, err := c.Getv1policies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1policiesResponse(rsp)
+}
+
+// Postv1policiesWithBodyWithResponse request with arbitrary body returning *Postv1policiesResponse
+func (c *ClientWithResponses) Postv1policiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1policiesResponse, error) {
+	rsp, err := c.Postv1policiesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1policiesResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1policiesWithResponse(ctx context.Context, body Postv1policiesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1policiesResponse, error) {
+	rsp, err := c.Postv1policies(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1policiesResponse(rsp)
+}
+
+// DeletepolicyItemWithResponse request returning *DeletepolicyItemResponse
+func (c *ClientWithResponses) DeletepolicyItemWithResponse(ctx context.Context, policyId PolicyId, params *DeletepolicyItemParams, reqEditors ...RequestEditorFn) (*DeletepolicyItemResponse, error) {
+	rsp, err := c.DeletepolicyItem(ctx, policyId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletepolicyItemResponse(rsp)
+}
+
+// GetpolicyItemWithResponse request returning *GetpolicyItemResponse
+func (c *ClientWithResponses) GetpolicyItemWithResponse(ctx context.Context, policyId PolicyId, reqEditors ...RequestEditorFn) (*GetpolicyItemResponse, error) {
+	rsp, err := c.GetpolicyItem(ctx, policyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetpolicyItemResponse(rsp)
+}
+
+// PatchpolicyItemWithBodyWithResponse request with arbitrary body returning *PatchpolicyItemResponse
+func (c *ClientWithResponses) PatchpolicyItemWithBodyWithResponse(ctx context.Context, policyId PolicyId, params *PatchpolicyItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchpolicyItemResponse, error) {
+	rsp, err := c.PatchpolicyItemWithBody(ctx, policyId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchpolicyItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchpolicyItemWithResponse(ctx context.Context, policyId PolicyId, params *PatchpolicyItemParams, body PatchpolicyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchpolicyItemResponse, error) {
+	rsp, err := c.PatchpolicyItem(ctx, policyId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchpolicyItemResponse(rsp)
+}
+
+// PutpolicyItemWithBodyWithResponse request with arbitrary body returning *PutpolicyItemResponse
+func (c *ClientWithResponses) PutpolicyItemWithBodyWithResponse(ctx context.Context, policyId PolicyId, params *PutpolicyItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutpolicyItemResponse, error) {
+	rsp, err := c.PutpolicyItemWithBody(ctx, policyId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutpolicyItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutpolicyItemWithResponse(ctx context.Context, policyId PolicyId, params *PutpolicyItemParams, body PutpolicyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutpolicyItemResponse, error) {
+	rsp, err := c.PutpolicyItem(ctx, policyId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutpolicyItemResponse(rsp)
+}
+
+// Getv1runkubebackupWithResponse request returning *Getv1runkubebackupResponse
+func (c *ClientWithResponses) Getv1runkubebackupWithResponse(ctx context.Context, params *Getv1runkubebackupParams, reqEditors ...RequestEditorFn) (*Getv1runkubebackupResponse, error) {
+	rsp, err := c.Getv1runkubebackup(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1runkubebackupResponse(rsp)
+}
+
+// Postv1runkubebackupWithBodyWithResponse request with arbitrary body returning *Postv1runkubebackupResponse
+func (c *ClientWithResponses) Postv1runkubebackupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1runkubebackupResponse, error) {
+	rsp, err := c.Postv1runkubebackupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1runkubebackupResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1runkubebackupWithResponse(ctx context.Context, body Postv1runkubebackupJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1runkubebackupResponse, error) {
+	rsp, err := c.Postv1runkubebackup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1runkubebackupResponse(rsp)
+}
+
+// DeleteRunkubebackupItemWithResponse request returning *DeleteRunkubebackupItemResponse
+func (c *ClientWithResponses) DeleteRunkubebackupItemWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *DeleteRunkubebackupItemParams, reqEditors ...RequestEditorFn) (*DeleteRunkubebackupItemResponse, error) {
+	rsp, err := c.DeleteRunkubebackupItem(ctx, runkubebackupId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRunkubebackupItemResponse(rsp)
+}
+
+// GetRunkubebackupItemWithResponse request returning *GetRunkubebackupItemResponse
+func (c *ClientWithResponses) GetRunkubebackupItemWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, reqEditors ...RequestEditorFn) (*GetRunkubebackupItemResponse, error) {
+	rsp, err := c.GetRunkubebackupItem(ctx, runkubebackupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRunkubebackupItemResponse(rsp)
+}
+
+// PatchRunkubebackupItemWithBodyWithResponse request with arbitrary body returning *PatchRunkubebackupItemResponse
+func (c *ClientWithResponses) PatchRunkubebackupItemWithBodyWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchRunkubebackupItemResponse, error) {
+	rsp, err := c.PatchRunkubebackupItemWithBody(ctx, runkubebackupId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchRunkubebackupItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchRunkubebackupItemWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *PatchRunkubebackupItemParams, body PatchRunkubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchRunkubebackupItemResponse, error) {
+	rsp, err := c.PatchRunkubebackupItem(ctx, runkubebackupId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchRunkubebackupItemResponse(rsp)
+}
+
+// PutRunkubebackupItemWithBodyWithResponse request with arbitrary body returning *PutRunkubebackupItemResponse
+func (c *ClientWithResponses) PutRunkubebackupItemWithBodyWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutRunkubebackupItemResponse, error) {
+	rsp, err := c.PutRunkubebackupItemWithBody(ctx, runkubebackupId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutRunkubebackupItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutRunkubebackupItemWithResponse(ctx context.Context, runkubebackupId RunkubebackupId, params *PutRunkubebackupItemParams, body PutRunkubebackupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutRunkubebackupItemResponse, error) {
+	rsp, err := c.PutRunkubebackupItem(ctx, runkubebackupId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutRunkubebackupItemResponse(rsp)
+}
+
+// Getv1usergroupsWithResponse request returning *Getv1usergroupsResponse
+func (c *ClientWithResponses) Getv1usergroupsWithResponse(ctx context.Context, params *Getv1usergroupsParams, reqEditors ...RequestEditorFn) (*Getv1usergroupsResponse, error) {
+	rsp, err := c.Getv1usergroups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetv1usergroupsResponse(rsp)
+}
+
+// Postv1usergroupsWithBodyWithResponse request with arbitrary body returning *Postv1usergroupsResponse
+func (c *ClientWithResponses) Postv1usergroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1usergroupsResponse, error) {
+	rsp, err := c.Postv1usergroupsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1usergroupsResponse(rsp)
+}
+
+func (c *ClientWithResponses) Postv1usergroupsWithResponse(ctx context.Context, body Postv1usergroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1usergroupsResponse, error) {
+	rsp, err := c.Postv1usergroups(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostv1usergroupsResponse(rsp)
+}
+
+// DeleteUsergroupItemWithResponse request returning *DeleteUsergroupItemResponse
+func (c *ClientWithResponses) DeleteUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *DeleteUsergroupItemParams, reqEditors ...RequestEditorFn) (*DeleteUsergroupItemResponse, error) {
+	rsp, err := c.DeleteUsergroupItem(ctx, usergroupId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsergroupItemResponse(rsp)
+}
+
+// GetUsergroupItemWithResponse request returning *GetUsergroupItemResponse
+func (c *ClientWithResponses) GetUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, reqEditors ...RequestEditorFn) (*GetUsergroupItemResponse, error) {
+	rsp, err := c.GetUsergroupItem(ctx, usergroupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsergroupItemResponse(rsp)
+}
+
+// PatchUsergroupItemWithBodyWithResponse request with arbitrary body returning *PatchUsergroupItemResponse
+func (c *ClientWithResponses) PatchUsergroupItemWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsergroupItemResponse, error) {
+	rsp, err := c.PatchUsergroupItemWithBody(ctx, usergroupId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUsergroupItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, body PatchUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsergroupItemResponse, error) {
+	rsp, err := c.PatchUsergroupItem(ctx, usergroupId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUsergroupItemResponse(rsp)
+}
+
+// PutUsergroupItemWithBodyWithResponse request with arbitrary body returning *PutUsergroupItemResponse
+func (c *ClientWithResponses) PutUsergroupItemWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsergroupItemResponse, error) {
+	rsp, err := c.PutUsergroupItemWithBody(ctx, usergroupId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	JSONDefault *Error
+	return ParsePutUsergroupItemResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r Getv1kubenamespacesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PutUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, body PutUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsergroupItemResponse, error) {
+	rsp, err := c.PutUsergroupItem(ctx, usergroupId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePutUsergroupItemResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Getv1kubenamespacesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateUserGroupACLWithBodyWithResponse request with arbitrary body returning *UpdateUserGroupACLResponse
+func (c *ClientWithResponses) UpdateUserGroupACLWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserGroupACLResponse, error) {
+	rsp, err := c.UpdateUserGroupACLWithBody(ctx, usergroupId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateUserGroupACLResponse(rsp)
 }
 
-type Postv1kubenamespacesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+func (c *ClientWithResponses) UpdateUserGroupACLWithResponse(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, body UpdateUserGroupACLJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserGroupACLResponse, error) {
+	rsp, err := c.UpdateUserGroupACL(ctx, usergroupId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserGroupACLResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r Postv1kubenamespacesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// Getv1usersWithResponse request returning *Getv1usersResponse
+func (c *ClientWithResponses) Getv1usersWithResponse(ctx context.Context, params *Getv1usersParams, reqEditors ...RequestEditorFn) (*Getv1usersResponse, error) {
+	rsp, err := c.Getv1users(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetv1usersResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Postv1kubenamespacesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetUserItemWithResponse request returning *GetUserItemResponse
+func (c *ClientWithResponses) GetUserItemWithResponse(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*GetUserItemResponse, error) {
+	rsp, err := c.GetUserItem(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetUserItemResponse(rsp)
 }
 
-type DeleteKubenamespaceItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// PatchUserItemWithBodyWithResponse request with arbitrary body returning *PatchUserItemResponse
+func (c *ClientWithResponses) PatchUserItemWithBodyWithResponse(ctx context.Context, userId UserId, params *PatchUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUserItemResponse, error) {
+	rsp, err := c.PatchUserItemWithBody(ctx, userId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUserItemResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteKubenamespaceItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PatchUserItemWithResponse(ctx context.Context, userId UserId, params *PatchUserItemParams, body PatchUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUserItemResponse, error) {
+	rsp, err := c.PatchUserItem(ctx, userId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePatchUserItemResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteKubenamespaceItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PutUserItemWithBodyWithResponse request with arbitrary body returning *PutUserItemResponse
+func (c *ClientWithResponses) PutUserItemWithBodyWithResponse(ctx context.Context, userId UserId, params *PutUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUserItemResponse, error) {
+	rsp, err := c.PutUserItemWithBody(ctx, userId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePutUserItemResponse(rsp)
 }
 
-type GetKubenamespaceItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Kubenamespace
-	JSONDefault  *Error
+func (c *ClientWithResponses) PutUserItemWithResponse(ctx context.Context, userId UserId, params *PutUserItemParams, body PutUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUserItemResponse, error) {
+	rsp, err := c.PutUserItem(ctx, userId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUserItemResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetKubenamespaceItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetv1backupinstancesResponse parses an HTTP response from a Getv1backupinstancesWithResponse call
+func ParseGetv1backupinstancesResponse(rsp *http.Response) (*Getv1backupinstancesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &Getv1backupinstancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items *[]Backupinstance `json:"_items,omitempty"`
+			Links *ResponeLinks     `json:"_links,omitempty"`
+			Meta  *ResponeMetadata  `json:"_meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetKubenamespaceItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParsePostv1backupinstancesResponse parses an HTTP response from a Postv1backupinstancesWithResponse call
+func ParsePostv1backupinstancesResponse(rsp *http.Response) (*Postv1backupinstancesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &Postv1backupinstancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-type PatchKubenamespaceItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// ParseDeleteBackupinstanceItemResponse parses an HTTP response from a DeleteBackupinstanceItemWithResponse call
+func ParseDeleteBackupinstanceItemResponse(rsp *http.Response) (*DeleteBackupinstanceItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBackupinstanceItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchKubenamespaceItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetBackupinstanceItemResponse parses an HTTP response from a GetBackupinstanceItemWithResponse call
+func ParseGetBackupinstanceItemResponse(rsp *http.Response) (*GetBackupinstanceItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &GetBackupinstanceItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Backupinstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchBackupinstanceItemResponse parses an HTTP response from a PatchBackupinstanceItemWithResponse call
+func ParsePatchBackupinstanceItemResponse(rsp *http.Response) (*PatchBackupinstanceItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchBackupinstanceItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchKubenamespaceItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParsePutBackupinstanceItemResponse parses an HTTP response from a PutBackupinstanceItemWithResponse call
+func ParsePutBackupinstanceItemResponse(rsp *http.Response) (*PutBackupinstanceItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutBackupinstanceItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
-}
 
-type PutKubenamespaceItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutKubenamespaceItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetv1internalaclsResponse parses an HTTP response from a Getv1internalaclsWithResponse call
+func ParseGetv1internalaclsResponse(rsp *http.Response) (*Getv1internalaclsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutKubenamespaceItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &Getv1internalaclsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type Deletev1orginvitesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items *[]Internalacl   `json:"_items,omitempty"`
+			Links *ResponeLinks    `json:"_links,omitempty"`
+			Meta  *ResponeMetadata `json:"_meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r Deletev1orginvitesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Deletev1orginvitesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type Getv1orginvitesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Items *[]Orginvite     `json:"_items,omitempty"`
-		Links *ResponeLinks    `json:"_links,omitempty"`
-		Meta  *ResponeMetadata `json:"_meta,omitempty"`
-	}
-	JSONDefault *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r Getv1orginvitesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePostv1internalaclsResponse parses an HTTP response from a Postv1internalaclsWithResponse call
+func ParsePostv1internalaclsResponse(rsp *http.Response) (*Postv1internalaclsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Getv1orginvitesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &Postv1internalaclsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type Postv1orginvitesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r Postv1orginvitesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Postv1orginvitesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeleteOrginviteItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+// ParseDeleteInternalaclItemResponse parses an HTTP response from a DeleteInternalaclItemWithResponse call
+func ParseDeleteInternalaclItemResponse(rsp *http.Response) (*DeleteInternalaclItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteOrginviteItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeleteInternalaclItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteOrginviteItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
-}
 
-type GetOrginviteItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Orginvite
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOrginviteItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetInternalaclItemResponse parses an HTTP response from a GetInternalaclItemWithResponse call
+func ParseGetInternalaclItemResponse(rsp *http.Response) (*GetInternalaclItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrginviteItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetInternalaclItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PatchOrginviteItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Internalacl
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PatchOrginviteItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchOrginviteItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PutOrginviteItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutOrginviteItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePatchInternalaclItemResponse parses an HTTP response from a PatchInternalaclItemWithResponse call
+func ParsePatchInternalaclItemResponse(rsp *http.Response) (*PatchInternalaclItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutOrginviteItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PatchInternalaclItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type Getv1orgsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Items *[]Org           `json:"_items,omitempty"`
-		Links *ResponeLinks    `json:"_links,omitempty"`
-		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	JSONDefault *Error
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r Getv1orgsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePutInternalaclItemResponse parses an HTTP response from a PutInternalaclItemWithResponse call
+func ParsePutInternalaclItemResponse(rsp *http.Response) (*PutInternalaclItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Getv1orgsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PutInternalaclItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetOrgItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Org
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetOrgItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOrgItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type PatchOrgItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+// ParseDeletev1jobsResponse parses an HTTP response from a Deletev1jobsWithResponse call
+func ParseDeletev1jobsResponse(rsp *http.Response) (*Deletev1jobsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PatchOrgItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &Deletev1jobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchOrgItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
-}
 
-type PutOrgItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutOrgItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetv1jobsResponse parses an HTTP response from a Getv1jobsWithResponse call
+func ParseGetv1jobsResponse(rsp *http.Response) (*Getv1jobsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutOrgItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &Getv1jobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type Getv1usergroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Items *[]Usergroup     `json:"_items,omitempty"`
-		Links *ResponeLinks    `json:"_links,omitempty"`
-		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items *[]Job           `json:"_items,omitempty"`
+			Links *ResponeLinks    `json:"_links,omitempty"`
+			Meta  *ResponeMetadata `json:"_meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	JSONDefault *Error
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r Getv1usergroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePostv1jobsResponse parses an HTTP response from a Postv1jobsWithResponse call
+func ParsePostv1jobsResponse(rsp *http.Response) (*Postv1jobsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Getv1usergroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &Postv1jobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type Postv1usergroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r Postv1usergroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Postv1usergroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeleteUsergroupItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+// ParseDeleteJobItemResponse parses an HTTP response from a DeleteJobItemWithResponse call
+func ParseDeleteJobItemResponse(rsp *http.Response) (*DeleteJobItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteUsergroupItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeleteJobItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUsergroupItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
-}
 
-type GetUsergroupItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Usergroup
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUsergroupItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetJobItemResponse parses an HTTP response from a GetJobItemWithResponse call
+func ParseGetJobItemResponse(rsp *http.Response) (*GetJobItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsergroupItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetJobItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PatchUsergroupItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PatchUsergroupItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchUsergroupItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PutUsergroupItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutUsergroupItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePatchJobItemResponse parses an HTTP response from a PatchJobItemWithResponse call
+func ParsePatchJobItemResponse(rsp *http.Response) (*PatchJobItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutUsergroupItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PatchJobItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateUserGroupACLResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ACLs
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateUserGroupACLResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateUserGroupACLResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type Getv1usersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Items *[]User          `json:"_items,omitempty"`
-		Links *ResponeLinks    `json:"_links,omitempty"`
-		Meta  *ResponeMetadata `json:"_meta,omitempty"`
+// ParsePutJobItemResponse parses an HTTP response from a PutJobItemWithResponse call
+func ParsePutJobItemResponse(rsp *http.Response) (*PutJobItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	JSONDefault *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r Getv1usersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &PutJobItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r Getv1usersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
-}
 
-type GetUserItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUserItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetv1kubebackupsResponse parses an HTTP response from a Getv1kubebackupsWithResponse call
+func ParseGetv1kubebackupsResponse(rsp *http.Response) (*Getv1kubebackupsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &Getv1kubebackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PatchUserItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items *[]Kubebackup    `json:"_items,omitempty"`
+			Links *ResponeLinks    `json:"_links,omitempty"`
+			Meta  *ResponeMetadata `json:"_meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PatchUserItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchUserItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PutUserItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutUserItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePostv1kubebackupsResponse parses an HTTP response from a Postv1kubebackupsWithResponse call
+func ParsePostv1kubebackupsResponse(rsp *http.Response) (*Postv1kubebackupsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutUserItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &Postv1kubebackupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// Getv1internalaclsWithResponse request returning *Getv1internalaclsResponse
-func (c *ClientWithResponses) Getv1internalaclsWithResponse(ctx context.Context, params *Getv1internalaclsParams, reqEditors ...RequestEditorFn) (*Getv1internalaclsResponse, error) {
-	rsp, err := c.Getv1internalacls(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetv1internalaclsResponse(rsp)
+
+	return response, nil
 }
 
-// Postv1internalaclsWithBodyWithResponse request with arbitrary body returning *Postv1internalaclsResponse
-func (c *ClientWithResponses) Postv1internalaclsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1internalaclsResponse, error) {
-	rsp, err := c.Postv1internalaclsWithBody(ctx, contentType, body, reqEditors...)
+// ParseDeleteKubebackupItemResponse parses an HTTP response from a DeleteKubebackupItemWithResponse call
+func ParseDeleteKubebackupItemResponse(rsp *http.Response) (*DeleteKubebackupItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostv1internalaclsResponse(rsp)
-}
 
-func (c *ClientWithResponses) Postv1internalaclsWithResponse(ctx context.Context, body Postv1internalaclsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1internalaclsResponse, error) {
-	rsp, err := c.Postv1internalacls(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteKubebackupItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostv1internalaclsResponse(rsp)
-}
 
-// DeleteInternalaclItemWithResponse request returning *DeleteInternalaclItemResponse
-func (c *ClientWithResponses) DeleteInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *DeleteInternalaclItemParams, reqEditors ...RequestEditorFn) (*DeleteInternalaclItemResponse, error) {
-	rsp, err := c.DeleteInternalaclItem(ctx, internalaclId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseDeleteInternalaclItemResponse(rsp)
+
+	return response, nil
 }
 
-// GetInternalaclItemWithResponse request returning *GetInternalaclItemResponse
-func (c *ClientWithResponses) GetInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, reqEditors ...RequestEditorFn) (*GetInternalaclItemResponse, error) {
-	rsp, err := c.GetInternalaclItem(ctx, internalaclId, reqEditors...)
+// ParseGetKubebackupItemResponse parses an HTTP response from a GetKubebackupItemWithResponse call
+func ParseGetKubebackupItemResponse(rsp *http.Response) (*GetKubebackupItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetInternalaclItemResponse(rsp)
-}
 
-// PatchInternalaclItemWithBodyWithResponse request with arbitrary body returning *PatchInternalaclItemResponse
-func (c *ClientWithResponses) PatchInternalaclItemWithBodyWithResponse(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchInternalaclItemResponse, error) {
-	rsp, err := c.PatchInternalaclItemWithBody(ctx, internalaclId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetKubebackupItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchInternalaclItemResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *PatchInternalaclItemParams, body PatchInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchInternalaclItemResponse, error) {
-	rsp, err := c.PatchInternalaclItem(ctx, internalaclId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Kubebackup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchInternalaclItemResponse(rsp)
+
+	return response, nil
 }
 
-// PutInternalaclItemWithBodyWithResponse request with arbitrary body returning *PutInternalaclItemResponse
-func (c *ClientWithResponses) PutInternalaclItemWithBodyWithResponse(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutInternalaclItemResponse, error) {
-	rsp, err := c.PutInternalaclItemWithBody(ctx, internalaclId, params, contentType, body, reqEditors...)
+// ParsePatchKubebackupItemResponse parses an HTTP response from a PatchKubebackupItemWithResponse call
+func ParsePatchKubebackupItemResponse(rsp *http.Response) (*PatchKubebackupItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutInternalaclItemResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutInternalaclItemWithResponse(ctx context.Context, internalaclId InternalaclId, params *PutInternalaclItemParams, body PutInternalaclItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutInternalaclItemResponse, error) {
-	rsp, err := c.PutInternalaclItem(ctx, internalaclId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchKubebackupItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutInternalaclItemResponse(rsp)
-}
 
-// Deletev1kubenamespacesWithResponse request returning *Deletev1kubenamespacesResponse
-func (c *ClientWithResponses) Deletev1kubenamespacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1kubenamespacesResponse, error) {
-	rsp, err := c.Deletev1kubenamespaces(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseDeletev1kubenamespacesResponse(rsp)
+
+	return response, nil
 }
 
-// Getv1kubenamespacesWithResponse request returning *Getv1kubenamespacesResponse
-func (c *ClientWithResponses) Getv1kubenamespacesWithResponse(ctx context.Context, params *Getv1kubenamespacesParams, reqEditors ...RequestEditorFn) (*Getv1kubenamespacesResponse, error) {
-	rsp, err := c.Getv1kubenamespaces(ctx, params, reqEditors...)
+// ParsePutKubebackupItemResponse parses an HTTP response from a PutKubebackupItemWithResponse call
+func ParsePutKubebackupItemResponse(rsp *http.Response) (*PutKubebackupItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetv1kubenamespacesResponse(rsp)
-}
 
-// Postv1kubenamespacesWithBodyWithResponse request with arbitrary body returning *Postv1kubenamespacesResponse
-func (c *ClientWithResponses) Postv1kubenamespacesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1kubenamespacesResponse, error) {
-	rsp, err := c.Postv1kubenamespacesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutKubebackupItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostv1kubenamespacesResponse(rsp)
-}
 
-func (c *ClientWithResponses) Postv1kubenamespacesWithResponse(ctx context.Context, body Postv1kubenamespacesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1kubenamespacesResponse, error) {
-	rsp, err := c.Postv1kubenamespaces(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePostv1kubenamespacesResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteKubenamespaceItemWithResponse request returning *DeleteKubenamespaceItemResponse
-func (c *ClientWithResponses) DeleteKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *DeleteKubenamespaceItemParams, reqEditors ...RequestEditorFn) (*DeleteKubenamespaceItemResponse, error) {
-	rsp, err := c.DeleteKubenamespaceItem(ctx, kubenamespaceId, params, reqEditors...)
+// ParseGetv1kubeclustersResponse parses an HTTP response from a Getv1kubeclustersWithResponse call
+func ParseGetv1kubeclustersResponse(rsp *http.Response) (*Getv1kubeclustersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteKubenamespaceItemResponse(rsp)
-}
 
-// GetKubenamespaceItemWithResponse request returning *GetKubenamespaceItemResponse
-func (c *ClientWithResponses) GetKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, reqEditors ...RequestEditorFn) (*GetKubenamespaceItemResponse, error) {
-	rsp, err := c.GetKubenamespaceItem(ctx, kubenamespaceId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &Getv1kubeclustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetKubenamespaceItemResponse(rsp)
-}
 
-// PatchKubenamespaceItemWithBodyWithResponse request with arbitrary body returning *PatchKubenamespaceItemResponse
-func (c *ClientWithResponses) PatchKubenamespaceItemWithBodyWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchKubenamespaceItemResponse, error) {
-	rsp, err := c.PatchKubenamespaceItemWithBody(ctx, kubenamespaceId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items *[]Kubecluster   `json:"_items,omitempty"`
+			Links *ResponeLinks    `json:"_links,omitempty"`
+			Meta  *ResponeMetadata `json:"_meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchKubenamespaceItemResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PatchKubenamespaceItemParams, body PatchKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchKubenamespaceItemResponse, error) {
-	rsp, err := c.PatchKubenamespaceItem(ctx, kubenamespaceId, params, body, reqEditors...)
+// ParsePostv1kubeclustersResponse parses an HTTP response from a Postv1kubeclustersWithResponse call
+func ParsePostv1kubeclustersResponse(rsp *http.Response) (*Postv1kubeclustersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchKubenamespaceItemResponse(rsp)
-}
 
-// PutKubenamespaceItemWithBodyWithResponse request with arbitrary body returning *PutKubenamespaceItemResponse
-func (c *ClientWithResponses) PutKubenamespaceItemWithBodyWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutKubenamespaceItemResponse, error) {
-	rsp, err := c.PutKubenamespaceItemWithBody(ctx, kubenamespaceId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &Postv1kubeclustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutKubenamespaceItemResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutKubenamespaceItemWithResponse(ctx context.Context, kubenamespaceId KubenamespaceId, params *PutKubenamespaceItemParams, body PutKubenamespaceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutKubenamespaceItemResponse, error) {
-	rsp, err := c.PutKubenamespaceItem(ctx, kubenamespaceId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePutKubenamespaceItemResponse(rsp)
+
+	return response, nil
 }
 
-// Deletev1orginvitesWithResponse request returning *Deletev1orginvitesResponse
-func (c *ClientWithResponses) Deletev1orginvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Deletev1orginvitesResponse, error) {
-	rsp, err := c.Deletev1orginvites(ctx, reqEditors...)
+// ParseDeleteKubeclusterItemResponse parses an HTTP response from a DeleteKubeclusterItemWithResponse call
+func ParseDeleteKubeclusterItemResponse(rsp *http.Response) (*DeleteKubeclusterItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletev1orginvitesResponse(rsp)
-}
 
-// Getv1orginvitesWithResponse request returning *Getv1orginvitesResponse
-func (c *ClientWithResponses) Getv1orginvitesWithResponse(ctx context.Context, params *Getv1orginvitesParams, reqEditors ...RequestEditorFn) (*Getv1orginvitesResponse, error) {
-	rsp, err := c.Getv1orginvites(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteKubeclusterItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetv1orginvitesResponse(rsp)
-}
 
-// Postv1orginvitesWithBodyWithResponse request with arbitrary body returning *Postv1orginvitesResponse
-func (c *ClientWithResponses) Postv1orginvitesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1orginvitesResponse, error) {
-	rsp, err := c.Postv1orginvitesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePostv1orginvitesResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) Postv1orginvitesWithResponse(ctx context.Context, body Postv1orginvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1orginvitesResponse, error) {
-	rsp, err := c.Postv1orginvites(ctx, body, reqEditors...)
+// ParseGetKubeclusterItemResponse parses an HTTP response from a GetKubeclusterItemWithResponse call
+func ParseGetKubeclusterItemResponse(rsp *http.Response) (*GetKubeclusterItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostv1orginvitesResponse(rsp)
-}
 
-// DeleteOrginviteItemWithResponse request returning *DeleteOrginviteItemResponse
-func (c *ClientWithResponses) DeleteOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *DeleteOrginviteItemParams, reqEditors ...RequestEditorFn) (*DeleteOrginviteItemResponse, error) {
-	rsp, err := c.DeleteOrginviteItem(ctx, orginviteId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetKubeclusterItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteOrginviteItemResponse(rsp)
-}
 
-// GetOrginviteItemWithResponse request returning *GetOrginviteItemResponse
-func (c *ClientWithResponses) GetOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, reqEditors ...RequestEditorFn) (*GetOrginviteItemResponse, error) {
-	rsp, err := c.GetOrginviteItem(ctx, orginviteId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Kubecluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetOrginviteItemResponse(rsp)
+
+	return response, nil
 }
 
-// PatchOrginviteItemWithBodyWithResponse request with arbitrary body returning *PatchOrginviteItemResponse
-func (c *ClientWithResponses) PatchOrginviteItemWithBodyWithResponse(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrginviteItemResponse, error) {
-	rsp, err := c.PatchOrginviteItemWithBody(ctx, orginviteId, params, contentType, body, reqEditors...)
+// ParsePatchKubeclusterItemResponse parses an HTTP response from a PatchKubeclusterItemWithResponse call
+func ParsePatchKubeclusterItemResponse(rsp *http.Response) (*PatchKubeclusterItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchOrginviteItemResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *PatchOrginviteItemParams, body PatchOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrginviteItemResponse, error) {
-	rsp, err := c.PatchOrginviteItem(ctx, orginviteId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchKubeclusterItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchOrginviteItemResponse(rsp)
-}
 
-// PutOrginviteItemWithBodyWithResponse request with arbitrary body returning *PutOrginviteItemResponse
-func (c *ClientWithResponses) PutOrginviteItemWithBodyWithResponse(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrginviteItemResponse, error) {
-	rsp, err := c.PutOrginviteItemWithBody(ctx, orginviteId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePutOrginviteItemResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutOrginviteItemWithResponse(ctx context.Context, orginviteId OrginviteId, params *PutOrginviteItemParams, body PutOrginviteItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrginviteItemResponse, error) {
-	rsp, err := c.PutOrginviteItem(ctx, orginviteId, params, body, reqEditors...)
+// ParsePutKubeclusterItemResponse parses an HTTP response from a PutKubeclusterItemWithResponse call
+func ParsePutKubeclusterItemResponse(rsp *http.Response) (*PutKubeclusterItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutOrginviteItemResponse(rsp)
-}
 
-// Getv1orgsWithResponse request returning *Getv1orgsResponse
-func (c *ClientWithResponses) Getv1orgsWithResponse(ctx context.Context, params *Getv1orgsParams, reqEditors ...RequestEditorFn) (*Getv1orgsResponse, error) {
-	rsp, err := c.Getv1orgs(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutKubeclusterItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetv1orgsResponse(rsp)
-}
 
-// GetOrgItemWithResponse request returning *GetOrgItemResponse
-func (c *ClientWithResponses) GetOrgItemWithResponse(ctx context.Context, orgId OrgId, reqEditors ...RequestEditorFn) (*GetOrgItemResponse, error) {
-	rsp, err := c.GetOrgItem(ctx, orgId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetOrgItemResponse(rsp)
+
+	return response, nil
 }
 
-// PatchOrgItemWithBodyWithResponse request with arbitrary body returning *PatchOrgItemResponse
-func (c *ClientWithResponses) PatchOrgItemWithBodyWithResponse(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchOrgItemResponse, error) {
-	rsp, err := c.PatchOrgItemWithBody(ctx, orgId, params, contentType, body, reqEditors...)
+// ParseDeletev1kubenamespacesResponse parses an HTTP response from a Deletev1kubenamespacesWithResponse call
+func ParseDeletev1kubenamespacesResponse(rsp *http.Response) (*Deletev1kubenamespacesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchOrgItemResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchOrgItemWithResponse(ctx context.Context, orgId OrgId, params *PatchOrgItemParams, body PatchOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchOrgItemResponse, error) {
-	rsp, err := c.PatchOrgItem(ctx, orgId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &Deletev1kubenamespacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchOrgItemResponse(rsp)
-}
 
-// PutOrgItemWithBodyWithResponse request with arbitrary body returning *PutOrgItemResponse
-func (c *ClientWithResponses) PutOrgItemWithBodyWithResponse(ctx context.Context, orgId OrgId, params *PutOrgItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrgItemResponse, error) {
-	rsp, err := c.PutOrgItemWithBody(ctx, orgId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePutOrgItemResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutOrgItemWithResponse(ctx context.Context, orgId OrgId, params *PutOrgItemParams, body PutOrgItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrgItemResponse, error) {
-	rsp, err := c.PutOrgItem(ctx, orgId, params, body, reqEditors...)
+// ParseGetv1kubenamespacesResponse parses an HTTP response from a Getv1kubenamespacesWithResponse call
+func ParseGetv1kubenamespacesResponse(rsp *http.Response) (*Getv1kubenamespacesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutOrgItemResponse(rsp)
-}
 
-// Getv1usergroupsWithResponse request returning *Getv1usergroupsResponse
-func (c *ClientWithResponses) Getv1usergroupsWithResponse(ctx context.Context, params *Getv1usergroupsParams, reqEditors ...RequestEditorFn) (*Getv1usergroupsResponse, error) {
-	rsp, err := c.Getv1usergroups(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &Getv1kubenamespacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetv1usergroupsResponse(rsp)
-}
 
-// Postv1usergroupsWithBodyWithResponse request with arbitrary body returning *Postv1usergroupsResponse
-func (c *ClientWithResponses) Postv1usergroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Postv1usergroupsResponse, error) {
-	rsp, err := c.Postv1usergroupsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items *[]Kubenamespace `json:"_items,omitempty"`
+			Links *ResponeLinks    `json:"_links,omitempty"`
+			Meta  *ResponeMetadata `json:"_meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePostv1usergroupsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) Postv1usergroupsWithResponse(ctx context.Context, body Postv1usergroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*Postv1usergroupsResponse, error) {
-	rsp, err := c.Postv1usergroups(ctx, body, reqEditors...)
+// ParsePostv1kubenamespacesResponse parses an HTTP response from a Postv1kubenamespacesWithResponse call
+func ParsePostv1kubenamespacesResponse(rsp *http.Response) (*Postv1kubenamespacesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostv1usergroupsResponse(rsp)
-}
 
-// DeleteUsergroupItemWithResponse request returning *DeleteUsergroupItemResponse
-func (c *ClientWithResponses) DeleteUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *DeleteUsergroupItemParams, reqEditors ...RequestEditorFn) (*DeleteUsergroupItemResponse, error) {
-	rsp, err := c.DeleteUsergroupItem(ctx, usergroupId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &Postv1kubenamespacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteUsergroupItemResponse(rsp)
-}
 
-// GetUsergroupItemWithResponse request returning *GetUsergroupItemResponse
-func (c *ClientWithResponses) GetUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, reqEditors ...RequestEditorFn) (*GetUsergroupItemResponse, error) {
-	rsp, err := c.GetUsergroupItem(ctx, usergroupId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetUsergroupItemResponse(rsp)
+
+	return response, nil
 }
 
-// PatchUsergroupItemWithBodyWithResponse request with arbitrary body returning *PatchUsergroupItemResponse
-func (c *ClientWithResponses) PatchUsergroupItemWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsergroupItemResponse, error) {
-	rsp, err := c.PatchUsergroupItemWithBody(ctx, usergroupId, params, contentType, body, reqEditors...)
+// ParseDeleteKubenamespaceItemResponse parses an HTTP response from a DeleteKubenamespaceItemWithResponse call
+func ParseDeleteKubenamespaceItemResponse(rsp *http.Response) (*DeleteKubenamespaceItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchUsergroupItemResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *PatchUsergroupItemParams, body PatchUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsergroupItemResponse, error) {
-	rsp, err := c.PatchUsergroupItem(ctx, usergroupId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteKubenamespaceItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchUsergroupItemResponse(rsp)
-}
 
-// PutUsergroupItemWithBodyWithResponse request with arbitrary body returning *PutUsergroupItemResponse
-func (c *ClientWithResponses) PutUsergroupItemWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsergroupItemResponse, error) {
-	rsp, err := c.PutUsergroupItemWithBody(ctx, usergroupId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePutUsergroupItemResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutUsergroupItemWithResponse(ctx context.Context, usergroupId UsergroupId, params *PutUsergroupItemParams, body PutUsergroupItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsergroupItemResponse, error) {
-	rsp, err := c.PutUsergroupItem(ctx, usergroupId, params, body, reqEditors...)
+// ParseGetKubenamespaceItemResponse parses an HTTP response from a GetKubenamespaceItemWithResponse call
+func ParseGetKubenamespaceItemResponse(rsp *http.Response) (*GetKubenamespaceItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutUsergroupItemResponse(rsp)
-}
 
-// UpdateUserGroupACLWithBodyWithResponse request with arbitrary body returning *UpdateUserGroupACLResponse
-func (c *ClientWithResponses) UpdateUserGroupACLWithBodyWithResponse(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserGroupACLResponse, error) {
-	rsp, err := c.UpdateUserGroupACLWithBody(ctx, usergroupId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetKubenamespaceItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateUserGroupACLResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateUserGroupACLWithResponse(ctx context.Context, usergroupId UsergroupId, params *UpdateUserGroupACLParams, body UpdateUserGroupACLJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserGroupACLResponse, error) {
-	rsp, err := c.UpdateUserGroupACL(ctx, usergroupId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Kubenamespace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseUpdateUserGroupACLResponse(rsp)
+
+	return response, nil
 }
 
-// Getv1usersWithResponse request returning *Getv1usersResponse
-func (c *ClientWithResponses) Getv1usersWithResponse(ctx context.Context, params *Getv1usersParams, reqEditors ...RequestEditorFn) (*Getv1usersResponse, error) {
-	rsp, err := c.Getv1users(ctx, params, reqEditors...)
+// ParsePatchKubenamespaceItemResponse parses an HTTP response from a PatchKubenamespaceItemWithResponse call
+func ParsePatchKubenamespaceItemResponse(rsp *http.Response) (*PatchKubenamespaceItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetv1usersResponse(rsp)
-}
 
-// GetUserItemWithResponse request returning *GetUserItemResponse
-func (c *ClientWithResponses) GetUserItemWithResponse(ctx context.Context, userId UserId, reqEditors ...RequestEditorFn) (*GetUserItemResponse, error) {
-	rsp, err := c.GetUserItem(ctx, userId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchKubenamespaceItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetUserItemResponse(rsp)
-}
 
-// PatchUserItemWithBodyWithResponse request with arbitrary body returning *PatchUserItemResponse
-func (c *ClientWithResponses) PatchUserItemWithBodyWithResponse(ctx context.Context, userId UserId, params *PatchUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUserItemResponse, error) {
-	rsp, err := c.PatchUserItemWithBody(ctx, userId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchUserItemResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchUserItemWithResponse(ctx context.Context, userId UserId, params *PatchUserItemParams, body PatchUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUserItemResponse, error) {
-	rsp, err := c.PatchUserItem(ctx, userId, params, body, reqEditors...)
+// ParsePutKubenamespaceItemResponse parses an HTTP response from a PutKubenamespaceItemWithResponse call
+func ParsePutKubenamespaceItemResponse(rsp *http.Response) (*PutKubenamespaceItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchUserItemResponse(rsp)
-}
 
-// PutUserItemWithBodyWithResponse request with arbitrary body returning *PutUserItemResponse
-func (c *ClientWithResponses) PutUserItemWithBodyWithResponse(ctx context.Context, userId UserId, params *PutUserItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUserItemResponse, error) {
-	rsp, err := c.PutUserItemWithBody(ctx, userId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutKubenamespaceItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutUserItemResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutUserItemWithResponse(ctx context.Context, userId UserId, params *PutUserItemParams, body PutUserItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUserItemResponse, error) {
-	rsp, err := c.PutUserItem(ctx, userId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePutUserItemResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetv1internalaclsResponse parses an HTTP response from a Getv1internalaclsWithResponse call
-func ParseGetv1internalaclsResponse(rsp *http.Response) (*Getv1internalaclsResponse, error) {
+// ParseGetv1kuberestoresResponse parses an HTTP response from a Getv1kuberestoresWithResponse call
+func ParseGetv1kuberestoresResponse(rsp *http.Response) (*Getv1kuberestoresResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &Getv1internalaclsResponse{
+	response := &Getv1kuberestoresResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4778,7 +11815,7 @@ func ParseGetv1internalaclsResponse(rsp *http.Response) (*Getv1internalaclsRespo
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Items *[]Internalacl   `json:"_items,omitempty"`
+			Items *[]Kuberestore   `json:"_items,omitempty"`
 			Links *ResponeLinks    `json:"_links,omitempty"`
 			Meta  *ResponeMetadata `json:"_meta,omitempty"`
 		}
@@ -4799,15 +11836,15 @@ func ParseGetv1internalaclsResponse(rsp *http.Response) (*Getv1internalaclsRespo
 	return response, nil
 }
 
-// ParsePostv1internalaclsResponse parses an HTTP response from a Postv1internalaclsWithResponse call
-func ParsePostv1internalaclsResponse(rsp *http.Response) (*Postv1internalaclsResponse, error) {
+// ParsePostv1kuberestoresResponse parses an HTTP response from a Postv1kuberestoresWithResponse call
+func ParsePostv1kuberestoresResponse(rsp *http.Response) (*Postv1kuberestoresResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &Postv1internalaclsResponse{
+	response := &Postv1kuberestoresResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4825,15 +11862,15 @@ func ParsePostv1internalaclsResponse(rsp *http.Response) (*Postv1internalaclsRes
 	return response, nil
 }
 
-// ParseDeleteInternalaclItemResponse parses an HTTP response from a DeleteInternalaclItemWithResponse call
-func ParseDeleteInternalaclItemResponse(rsp *http.Response) (*DeleteInternalaclItemResponse, error) {
+// ParseDeleteKuberestoreItemResponse parses an HTTP response from a DeleteKuberestoreItemWithResponse call
+func ParseDeleteKuberestoreItemResponse(rsp *http.Response) (*DeleteKuberestoreItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteInternalaclItemResponse{
+	response := &DeleteKuberestoreItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4851,22 +11888,22 @@ func ParseDeleteInternalaclItemResponse(rsp *http.Response) (*DeleteInternalaclI
 	return response, nil
 }
 
-// ParseGetInternalaclItemResponse parses an HTTP response from a GetInternalaclItemWithResponse call
-func ParseGetInternalaclItemResponse(rsp *http.Response) (*GetInternalaclItemResponse, error) {
+// ParseGetKuberestoreItemResponse parses an HTTP response from a GetKuberestoreItemWithResponse call
+func ParseGetKuberestoreItemResponse(rsp *http.Response) (*GetKuberestoreItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInternalaclItemResponse{
+	response := &GetKuberestoreItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Internalacl
+		var dest Kuberestore
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4884,15 +11921,15 @@ func ParseGetInternalaclItemResponse(rsp *http.Response) (*GetInternalaclItemRes
 	return response, nil
 }
 
-// ParsePatchInternalaclItemResponse parses an HTTP response from a PatchInternalaclItemWithResponse call
-func ParsePatchInternalaclItemResponse(rsp *http.Response) (*PatchInternalaclItemResponse, error) {
+// ParsePatchKuberestoreItemResponse parses an HTTP response from a PatchKuberestoreItemWithResponse call
+func ParsePatchKuberestoreItemResponse(rsp *http.Response) (*PatchKuberestoreItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchInternalaclItemResponse{
+	response := &PatchKuberestoreItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4910,15 +11947,15 @@ func ParsePatchInternalaclItemResponse(rsp *http.Response) (*PatchInternalaclIte
 	return response, nil
 }
 
-// ParsePutInternalaclItemResponse parses an HTTP response from a PutInternalaclItemWithResponse call
-func ParsePutInternalaclItemResponse(rsp *http.Response) (*PutInternalaclItemResponse, error) {
+// ParsePutKuberestoreItemResponse parses an HTTP response from a PutKuberestoreItemWithResponse call
+func ParsePutKuberestoreItemResponse(rsp *http.Response) (*PutKuberestoreItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutInternalaclItemResponse{
+	response := &PutKuberestoreItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4936,15 +11973,15 @@ func ParsePutInternalaclItemResponse(rsp *http.Response) (*PutInternalaclItemRes
 	return response, nil
 }
 
-// ParseDeletev1kubenamespacesResponse parses an HTTP response from a Deletev1kubenamespacesWithResponse call
-func ParseDeletev1kubenamespacesResponse(rsp *http.Response) (*Deletev1kubenamespacesResponse, error) {
+// ParseDeletev1orginvitesResponse parses an HTTP response from a Deletev1orginvitesWithResponse call
+func ParseDeletev1orginvitesResponse(rsp *http.Response) (*Deletev1orginvitesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &Deletev1kubenamespacesResponse{
+	response := &Deletev1orginvitesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4962,15 +11999,15 @@ func ParseDeletev1kubenamespacesResponse(rsp *http.Response) (*Deletev1kubenames
 	return response, nil
 }
 
-// ParseGetv1kubenamespacesResponse parses an HTTP response from a Getv1kubenamespacesWithResponse call
-func ParseGetv1kubenamespacesResponse(rsp *http.Response) (*Getv1kubenamespacesResponse, error) {
+// ParseGetv1orginvitesResponse parses an HTTP response from a Getv1orginvitesWithResponse call
+func ParseGetv1orginvitesResponse(rsp *http.Response) (*Getv1orginvitesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &Getv1kubenamespacesResponse{
+	response := &Getv1orginvitesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4978,7 +12015,7 @@ func ParseGetv1kubenamespacesResponse(rsp *http.Response) (*Getv1kubenamespacesR
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Items *[]Kubenamespace `json:"_items,omitempty"`
+			Items *[]Orginvite     `json:"_items,omitempty"`
 			Links *ResponeLinks    `json:"_links,omitempty"`
 			Meta  *ResponeMetadata `json:"_meta,omitempty"`
 		}
@@ -4999,15 +12036,15 @@ func ParseGetv1kubenamespacesResponse(rsp *http.Response) (*Getv1kubenamespacesR
 	return response, nil
 }
 
-// ParsePostv1kubenamespacesResponse parses an HTTP response from a Postv1kubenamespacesWithResponse call
-func ParsePostv1kubenamespacesResponse(rsp *http.Response) (*Postv1kubenamespacesResponse, error) {
+// ParsePostv1orginvitesResponse parses an HTTP response from a Postv1orginvitesWithResponse call
+func ParsePostv1orginvitesResponse(rsp *http.Response) (*Postv1orginvitesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &Postv1kubenamespacesResponse{
+	response := &Postv1orginvitesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5025,15 +12062,15 @@ func ParsePostv1kubenamespacesResponse(rsp *http.Response) (*Postv1kubenamespace
 	return response, nil
 }
 
-// ParseDeleteKubenamespaceItemResponse parses an HTTP response from a DeleteKubenamespaceItemWithResponse call
-func ParseDeleteKubenamespaceItemResponse(rsp *http.Response) (*DeleteKubenamespaceItemResponse, error) {
+// ParseDeleteOrginviteItemResponse parses an HTTP response from a DeleteOrginviteItemWithResponse call
+func ParseDeleteOrginviteItemResponse(rsp *http.Response) (*DeleteOrginviteItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteKubenamespaceItemResponse{
+	response := &DeleteOrginviteItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5051,22 +12088,22 @@ func ParseDeleteKubenamespaceItemResponse(rsp *http.Response) (*DeleteKubenamesp
 	return response, nil
 }
 
-// ParseGetKubenamespaceItemResponse parses an HTTP response from a GetKubenamespaceItemWithResponse call
-func ParseGetKubenamespaceItemResponse(rsp *http.Response) (*GetKubenamespaceItemResponse, error) {
+// ParseGetOrginviteItemResponse parses an HTTP response from a GetOrginviteItemWithResponse call
+func ParseGetOrginviteItemResponse(rsp *http.Response) (*GetOrginviteItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetKubenamespaceItemResponse{
+	response := &GetOrginviteItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Kubenamespace
+		var dest Orginvite
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5084,15 +12121,15 @@ func ParseGetKubenamespaceItemResponse(rsp *http.Response) (*GetKubenamespaceIte
 	return response, nil
 }
 
-// ParsePatchKubenamespaceItemResponse parses an HTTP response from a PatchKubenamespaceItemWithResponse call
-func ParsePatchKubenamespaceItemResponse(rsp *http.Response) (*PatchKubenamespaceItemResponse, error) {
+// ParsePatchOrginviteItemResponse parses an HTTP response from a PatchOrginviteItemWithResponse call
+func ParsePatchOrginviteItemResponse(rsp *http.Response) (*PatchOrginviteItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchKubenamespaceItemResponse{
+	response := &PatchOrginviteItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5110,15 +12147,15 @@ func ParsePatchKubenamespaceItemResponse(rsp *http.Response) (*PatchKubenamespac
 	return response, nil
 }
 
-// ParsePutKubenamespaceItemResponse parses an HTTP response from a PutKubenamespaceItemWithResponse call
-func ParsePutKubenamespaceItemResponse(rsp *http.Response) (*PutKubenamespaceItemResponse, error) {
+// ParsePutOrginviteItemResponse parses an HTTP response from a PutOrginviteItemWithResponse call
+func ParsePutOrginviteItemResponse(rsp *http.Response) (*PutOrginviteItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutKubenamespaceItemResponse{
+	response := &PutOrginviteItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5136,20 +12173,31 @@ func ParsePutKubenamespaceItemResponse(rsp *http.Response) (*PutKubenamespaceIte
 	return response, nil
 }
 
-// ParseDeletev1orginvitesResponse parses an HTTP response from a Deletev1orginvitesWithResponse call
-func ParseDeletev1orginvitesResponse(rsp *http.Response) (*Deletev1orginvitesResponse, error) {
+// ParseGetv1orgsResponse parses an HTTP response from a Getv1orgsWithResponse call
+func ParseGetv1orgsResponse(rsp *http.Response) (*Getv1orgsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &Deletev1orginvitesResponse{
+	response := &Getv1orgsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items *[]Org           `json:"_items,omitempty"`
+			Links *ResponeLinks    `json:"_links,omitempty"`
+			Meta  *ResponeMetadata `json:"_meta,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5162,15 +12210,100 @@ func ParseDeletev1orginvitesResponse(rsp *http.Response) (*Deletev1orginvitesRes
 	return response, nil
 }
 
-// ParseGetv1orginvitesResponse parses an HTTP response from a Getv1orginvitesWithResponse call
-func ParseGetv1orginvitesResponse(rsp *http.Response) (*Getv1orginvitesResponse, error) {
+// ParseGetOrgItemResponse parses an HTTP response from a GetOrgItemWithResponse call
+func ParseGetOrgItemResponse(rsp *http.Response) (*GetOrgItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &Getv1orginvitesResponse{
+	response := &GetOrgItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Org
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchOrgItemResponse parses an HTTP response from a PatchOrgItemWithResponse call
+func ParsePatchOrgItemResponse(rsp *http.Response) (*PatchOrgItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchOrgItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutOrgItemResponse parses an HTTP response from a PutOrgItemWithResponse call
+func ParsePutOrgItemResponse(rsp *http.Response) (*PutOrgItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutOrgItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetv1policiesResponse parses an HTTP response from a Getv1policiesWithResponse call
+func ParseGetv1policiesResponse(rsp *http.Response) (*Getv1policiesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Getv1policiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5178,7 +12311,7 @@ func ParseGetv1orginvitesResponse(rsp *http.Response) (*Getv1orginvitesResponse,
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Items *[]Orginvite     `json:"_items,omitempty"`
+			Items *[]Policy        `json:"_items,omitempty"`
 			Links *ResponeLinks    `json:"_links,omitempty"`
 			Meta  *ResponeMetadata `json:"_meta,omitempty"`
 		}
@@ -5199,15 +12332,15 @@ func ParseGetv1orginvitesResponse(rsp *http.Response) (*Getv1orginvitesResponse,
 	return response, nil
 }
 
-// ParsePostv1orginvitesResponse parses an HTTP response from a Postv1orginvitesWithResponse call
-func ParsePostv1orginvitesResponse(rsp *http.Response) (*Postv1orginvitesResponse, error) {
+// ParsePostv1policiesResponse parses an HTTP response from a Postv1policiesWithResponse call
+func ParsePostv1policiesResponse(rsp *http.Response) (*Postv1policiesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &Postv1orginvitesResponse{
+	response := &Postv1policiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5225,15 +12358,15 @@ func ParsePostv1orginvitesResponse(rsp *http.Response) (*Postv1orginvitesRespons
 	return response, nil
 }
 
-// ParseDeleteOrginviteItemResponse parses an HTTP response from a DeleteOrginviteItemWithResponse call
-func ParseDeleteOrginviteItemResponse(rsp *http.Response) (*DeleteOrginviteItemResponse, error) {
+// ParseDeletepolicyItemResponse parses an HTTP response from a DeletepolicyItemWithResponse call
+func ParseDeletepolicyItemResponse(rsp *http.Response) (*DeletepolicyItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteOrginviteItemResponse{
+	response := &DeletepolicyItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5251,22 +12384,22 @@ func ParseDeleteOrginviteItemResponse(rsp *http.Response) (*DeleteOrginviteItemR
 	return response, nil
 }
 
-// ParseGetOrginviteItemResponse parses an HTTP response from a GetOrginviteItemWithResponse call
-func ParseGetOrginviteItemResponse(rsp *http.Response) (*GetOrginviteItemResponse, error) {
+// ParseGetpolicyItemResponse parses an HTTP response from a GetpolicyItemWithResponse call
+func ParseGetpolicyItemResponse(rsp *http.Response) (*GetpolicyItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrginviteItemResponse{
+	response := &GetpolicyItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Orginvite
+		var dest Policy
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5284,15 +12417,15 @@ func ParseGetOrginviteItemResponse(rsp *http.Response) (*GetOrginviteItemRespons
 	return response, nil
 }
 
-// ParsePatchOrginviteItemResponse parses an HTTP response from a PatchOrginviteItemWithResponse call
-func ParsePatchOrginviteItemResponse(rsp *http.Response) (*PatchOrginviteItemResponse, error) {
+// ParsePatchpolicyItemResponse parses an HTTP response from a PatchpolicyItemWithResponse call
+func ParsePatchpolicyItemResponse(rsp *http.Response) (*PatchpolicyItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchOrginviteItemResponse{
+	response := &PatchpolicyItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5310,15 +12443,15 @@ func ParsePatchOrginviteItemResponse(rsp *http.Response) (*PatchOrginviteItemRes
 	return response, nil
 }
 
-// ParsePutOrginviteItemResponse parses an HTTP response from a PutOrginviteItemWithResponse call
-func ParsePutOrginviteItemResponse(rsp *http.Response) (*PutOrginviteItemResponse, error) {
+// ParsePutpolicyItemResponse parses an HTTP response from a PutpolicyItemWithResponse call
+func ParsePutpolicyItemResponse(rsp *http.Response) (*PutpolicyItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutOrginviteItemResponse{
+	response := &PutpolicyItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5336,15 +12469,15 @@ func ParsePutOrginviteItemResponse(rsp *http.Response) (*PutOrginviteItemRespons
 	return response, nil
 }
 
-// ParseGetv1orgsResponse parses an HTTP response from a Getv1orgsWithResponse call
-func ParseGetv1orgsResponse(rsp *http.Response) (*Getv1orgsResponse, error) {
+// ParseGetv1runkubebackupResponse parses an HTTP response from a Getv1runkubebackupWithResponse call
+func ParseGetv1runkubebackupResponse(rsp *http.Response) (*Getv1runkubebackupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &Getv1orgsResponse{
+	response := &Getv1runkubebackupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5352,7 +12485,7 @@ func ParseGetv1orgsResponse(rsp *http.Response) (*Getv1orgsResponse, error) {
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Items *[]Org           `json:"_items,omitempty"`
+			Items *[]Runkubebackup `json:"_items,omitempty"`
 			Links *ResponeLinks    `json:"_links,omitempty"`
 			Meta  *ResponeMetadata `json:"_meta,omitempty"`
 		}
@@ -5373,22 +12506,74 @@ func ParseGetv1orgsResponse(rsp *http.Response) (*Getv1orgsResponse, error) {
 	return response, nil
 }
 
-// ParseGetOrgItemResponse parses an HTTP response from a GetOrgItemWithResponse call
-func ParseGetOrgItemResponse(rsp *http.Response) (*GetOrgItemResponse, error) {
+// ParsePostv1runkubebackupResponse parses an HTTP response from a Postv1runkubebackupWithResponse call
+func ParsePostv1runkubebackupResponse(rsp *http.Response) (*Postv1runkubebackupResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOrgItemResponse{
+	response := &Postv1runkubebackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRunkubebackupItemResponse parses an HTTP response from a DeleteRunkubebackupItemWithResponse call
+func ParseDeleteRunkubebackupItemResponse(rsp *http.Response) (*DeleteRunkubebackupItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRunkubebackupItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRunkubebackupItemResponse parses an HTTP response from a GetRunkubebackupItemWithResponse call
+func ParseGetRunkubebackupItemResponse(rsp *http.Response) (*GetRunkubebackupItemResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRunkubebackupItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Org
+		var dest Runkubebackup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5406,15 +12591,15 @@ func ParseGetOrgItemResponse(rsp *http.Response) (*GetOrgItemResponse, error) {
 	return response, nil
 }
 
-// ParsePatchOrgItemResponse parses an HTTP response from a PatchOrgItemWithResponse call
-func ParsePatchOrgItemResponse(rsp *http.Response) (*PatchOrgItemResponse, error) {
+// ParsePatchRunkubebackupItemResponse parses an HTTP response from a PatchRunkubebackupItemWithResponse call
+func ParsePatchRunkubebackupItemResponse(rsp *http.Response) (*PatchRunkubebackupItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchOrgItemResponse{
+	response := &PatchRunkubebackupItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5432,15 +12617,15 @@ func ParsePatchOrgItemResponse(rsp *http.Response) (*PatchOrgItemResponse, error
 	return response, nil
 }
 
-// ParsePutOrgItemResponse parses an HTTP response from a PutOrgItemWithResponse call
-func ParsePutOrgItemResponse(rsp *http.Response) (*PutOrgItemResponse, error) {
+// ParsePutRunkubebackupItemResponse parses an HTTP response from a PutRunkubebackupItemWithResponse call
+func ParsePutRunkubebackupItemResponse(rsp *http.Response) (*PutRunkubebackupItemResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutOrgItemResponse{
+	response := &PutRunkubebackupItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
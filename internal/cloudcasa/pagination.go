@@ -0,0 +1,137 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudcasa
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	goerr "github.com/pkg/errors"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// listPageSize is the number of items requested for each page, the API server could enforce a lower limit.
+const listPageSize = 100
+
+// pageFetcher retrieves the given page of an Eve-style list, returning the number of retrieved items along with the metadata.
+type pageFetcher func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (count int, meta *oapi.ResponeMetadata, err error)
+
+// paginate iterates over all the pages of an Eve-style list: since the generated links lack the next page reference,
+// the iteration relies on the metadata, stopping when the total has been reached, or on a partial page.
+func paginate(fetch pageFetcher) error {
+	fetched := 0
+
+	for page := oapi.QueryPage(1); ; page++ {
+		count, meta, err := fetch(page, listPageSize)
+		if err != nil {
+			return err
+		}
+
+		fetched += count
+
+		if !hasNextPage(count, fetched, meta) {
+			return nil
+		}
+	}
+}
+
+func hasNextPage(count, fetched int, meta *oapi.ResponeMetadata) bool {
+	switch {
+	case count == 0:
+		return false
+	case meta != nil && meta.Total != nil:
+		return fetched < *meta.Total
+	case meta != nil && meta.MaxResults != nil:
+		return count >= *meta.MaxResults
+	default:
+		return count >= listPageSize
+	}
+}
+
+// pageRequest performs the request of the given page of an Eve-style list.
+type pageRequest func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (*http.Response, error)
+
+// pageDecoder decodes the items of a page of an Eve-style list, returning their number.
+type pageDecoder func(items json.RawMessage) (int, error)
+
+// listPage is the envelope shared by the pages of the Eve-style lists, whose items depend on the listed resource.
+type listPage struct {
+	Items json.RawMessage       `json:"_items,omitempty"`
+	Meta  *oapi.ResponeMetadata `json:"_meta,omitempty"`
+}
+
+// listPages iterates over all the pages of the Eve-style list of the given resource kind,
+// handing the items of each page to the given decoder.
+func listPages(kind string, request pageRequest, decode pageDecoder) error {
+	return paginate(func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (int, *oapi.ResponeMetadata, error) {
+		res, err := request(page, maxResults)
+		if err != nil {
+			return 0, nil, goerr.Wrap(err, fmt.Sprintf("cannot retrieve list of CloudCasa %s", kind))
+		}
+
+		defer func() { _ = res.Body.Close() }()
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return 0, nil, goerr.Wrap(err, fmt.Sprintf("cannot read list of CloudCasa %s", kind))
+		}
+
+		isJSON := strings.Contains(res.Header.Get("Content-Type"), "json")
+
+		var apiError *oapi.Error
+
+		if res.StatusCode != http.StatusOK && isJSON {
+			apiError = &oapi.Error{}
+			if json.Unmarshal(body, apiError) != nil {
+				apiError = nil
+			}
+		}
+
+		if err = newAPIError(res.StatusCode, apiError); err != nil {
+			return 0, nil, err
+		}
+
+		if res.StatusCode != http.StatusOK || !isJSON {
+			return 0, nil, nil
+		}
+
+		var envelope listPage
+
+		if err = json.Unmarshal(body, &envelope); err != nil {
+			return 0, nil, goerr.Wrap(err, fmt.Sprintf("cannot decode list of CloudCasa %s", kind))
+		}
+
+		if len(envelope.Items) == 0 || string(envelope.Items) == "null" {
+			return 0, nil, nil
+		}
+
+		count, err := decode(envelope.Items)
+		if err != nil {
+			return 0, nil, goerr.Wrap(err, fmt.Sprintf("cannot decode list of CloudCasa %s", kind))
+		}
+
+		return count, envelope.Meta, nil
+	})
+}
+
+// collect returns the pageDecoder appending the items to the slice pointed by out.
+func collect(out interface{}) pageDecoder {
+	return func(items json.RawMessage) (int, error) {
+		slice := reflect.ValueOf(out).Elem()
+		page := reflect.New(slice.Type())
+
+		if err := json.Unmarshal(items, page.Interface()); err != nil {
+			return 0, err
+		}
+
+		slice.Set(reflect.AppendSlice(slice, page.Elem()))
+
+		return page.Elem().Len(), nil
+	}
+}
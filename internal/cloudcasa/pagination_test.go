@@ -0,0 +1,150 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudcasa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/auth"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/simulator"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestHasNextPage(t *testing.T) {
+	tests := map[string]struct {
+		count, fetched int
+		meta           *oapi.ResponeMetadata
+		expected       bool
+	}{
+		"empty page":                    {count: 0, fetched: 50, meta: &oapi.ResponeMetadata{Total: intPtr(100)}},
+		"total not reached":             {count: 50, fetched: 50, meta: &oapi.ResponeMetadata{Total: intPtr(120)}, expected: true},
+		"total reached":                 {count: 20, fetched: 120, meta: &oapi.ResponeMetadata{Total: intPtr(120)}},
+		"full page without total":       {count: 50, fetched: 50, meta: &oapi.ResponeMetadata{MaxResults: intPtr(50)}, expected: true},
+		"partial page without total":    {count: 20, fetched: 70, meta: &oapi.ResponeMetadata{MaxResults: intPtr(50)}},
+		"full page without metadata":    {count: listPageSize, fetched: listPageSize, expected: true},
+		"partial page without metadata": {count: listPageSize - 1, fetched: listPageSize - 1},
+	}
+
+	for name, tc := range tests {
+		if got := hasNextPage(tc.count, tc.fetched, tc.meta); got != tc.expected {
+			t.Fatalf("%s: expected %t, got %t", name, tc.expected, got)
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	// The server enforces a page size lower than the requested one
+	const total, serverPageSize = 120, 50
+
+	var pages []oapi.QueryPage
+
+	err := paginate(func(page oapi.QueryPage, maxResults oapi.QueryMaxResults) (int, *oapi.ResponeMetadata, error) {
+		pages = append(pages, page)
+
+		if maxResults != listPageSize {
+			return 0, nil, fmt.Errorf("expected %d results to be requested, got %d", listPageSize, maxResults)
+		}
+
+		count := total - (int(page)-1)*serverPageSize
+		if count > serverPageSize {
+			count = serverPageSize
+		}
+
+		return count, &oapi.ResponeMetadata{MaxResults: intPtr(serverPageSize), Page: intPtr(int(page)), Total: intPtr(total)}, nil
+	})
+	if err != nil {
+		t.Fatalf("cannot paginate: %s", err.Error())
+	}
+
+	if len(pages) != 3 {
+		t.Fatalf("expected 3 pages to be fetched, got %v", pages)
+	}
+
+	fetched := 0
+
+	err = paginate(func(oapi.QueryPage, oapi.QueryMaxResults) (int, *oapi.ResponeMetadata, error) {
+		fetched++
+
+		return 0, nil, fmt.Errorf("unavailable")
+	})
+	if err == nil || fetched != 1 {
+		t.Fatalf("expected the pagination to stop at the first failure, got %v after %d pages", err, fetched)
+	}
+}
+
+func newSimulatedClient(t *testing.T, token string) (*Client, *simulator.Simulator) {
+	t.Helper()
+
+	sim := simulator.New()
+	sim.Token = "token"
+
+	server := simulator.NewServer(sim)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL, auth.NewToken(token), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("cannot create the client: %s", err.Error())
+	}
+
+	return client, sim
+}
+
+func TestClientLists(t *testing.T) {
+	ctx := context.Background()
+
+	client, sim := newSimulatedClient(t, "token")
+
+	for i := 0; i < 120; i++ {
+		sim.Insert(simulator.KubeBackups, simulator.Document{"name": fmt.Sprintf("backup-%d", i), "cluster": "solar-cluster"})
+	}
+
+	sim.Insert(simulator.KubeBackups, simulator.Document{"name": "backup-0", "cluster": "oil-cluster"})
+	sim.Insert(simulator.KubeClusters, simulator.Document{"name": "solar-cluster"})
+	sim.Insert(simulator.KubeClusters, simulator.Document{"name": "oil-cluster"})
+	sim.Insert(simulator.KubeClusters, simulator.Document{"name": "oil-cluster"})
+
+	backups, err := client.ListBackups(ctx, BackupFilter{ClusterID: "solar-cluster"})
+	if err != nil {
+		t.Fatalf("cannot list the Kubebackups: %s", err.Error())
+	}
+
+	if len(backups) != 120 {
+		t.Fatalf("expected all the pages to be retrieved, got %d Kubebackups", len(backups))
+	}
+
+	if backup, err := client.FindBackup(ctx, "backup-1"); err != nil || backup == nil || backup.Name != "backup-1" {
+		t.Fatalf("expected the Kubebackup to be found, got %+v (%v)", backup, err)
+	}
+
+	if backup, err := client.FindBackup(ctx, "backup-0"); err == nil {
+		t.Fatalf("expected the ambiguous name to be reported, got %+v", backup)
+	}
+
+	if backup, err := client.FindBackup(ctx, "missing"); err != nil || backup != nil {
+		t.Fatalf("expected no Kubebackup to be found, got %+v (%v)", backup, err)
+	}
+
+	if cluster, err := client.FindCluster(ctx, "solar-cluster"); err != nil || cluster == nil {
+		t.Fatalf("expected the Kubecluster to be found, got %+v (%v)", cluster, err)
+	}
+
+	if cluster, err := client.FindCluster(ctx, "oil-cluster"); err == nil {
+		t.Fatalf("expected the ambiguous name to be reported, got %+v", cluster)
+	}
+}
+
+func TestClientListsFailure(t *testing.T) {
+	client, _ := newSimulatedClient(t, "expired")
+
+	if _, err := client.ListBackups(context.Background(), BackupFilter{}); statusCode(err) != http.StatusUnauthorized {
+		t.Fatalf("expected the API error to be returned, got %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudcasa
+
+import (
+	"context"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+// Service is the domain-level access to the CloudCasa API required by the controllers,
+// decoupling them from the generated oapi client.
+type Service interface {
+	UserGroups
+	Invites
+	Users
+	Namespaces
+	Clusters
+	Backups
+	Restores
+	Policies
+	Jobs
+	Orgs
+}
+
+type UserGroups interface {
+	// GetUserGroup returns the UserGroup with the given ID along with its ETag.
+	GetUserGroup(ctx context.Context, id string) (etag string, userGroup *oapi.Usergroup, err error)
+	// FindUserGroup returns the UserGroup with the given name along with its ETag, nil if it doesn't exist:
+	// an error is returned if multiple UserGroups share the name.
+	FindUserGroup(ctx context.Context, name string) (etag string, userGroup *oapi.Usergroup, err error)
+	// EnsureUserGroup returns the UserGroup with the given name, creating it with the given ACLs when missing.
+	EnsureUserGroup(ctx context.Context, name string, acls []oapi.UserGroupACL) (etag string, userGroup *oapi.Usergroup, err error)
+	UpdateUserGroupACL(ctx context.Context, id, etag string, acls []oapi.ACL) error
+	// PatchUserGroup updates the UserGroup name, users, and tags: nil fields are left untouched.
+	PatchUserGroup(ctx context.Context, id, etag string, userGroup oapi.Usergroup) error
+	// DeleteUserGroup deletes the UserGroup, tolerating it has been already deleted.
+	DeleteUserGroup(ctx context.Context, id, etag string) error
+}
+
+type Invites interface {
+	ListInvites(ctx context.Context, filter InviteFilter) ([]oapi.Orginvite, error)
+	CreateInvite(ctx context.Context, invite oapi.Orginvite) error
+	// DeleteInvite deletes the Orginvite, tolerating it has been already deleted.
+	DeleteInvite(ctx context.Context, id string) error
+}
+
+type Users interface {
+	ListUsers(ctx context.Context, filter UserFilter) ([]oapi.User, error)
+}
+
+type Namespaces interface {
+	// FindNamespace returns the Kubenamespace of the given cluster with the given name, nil if it doesn't exist.
+	FindNamespace(ctx context.Context, clusterID, name string) (*oapi.Kubenamespace, error)
+	// ResolveNamespaces returns the Kubenamespaces of the given cluster, indexed by name:
+	// the ones which don't exist are omitted.
+	ResolveNamespaces(ctx context.Context, clusterID string, names []string) (map[string]oapi.Kubenamespace, error)
+	// FindNamespaceByUID returns the Kubenamespace with the given name and Kubernetes UID, regardless of the cluster,
+	// nil if it doesn't exist.
+	FindNamespaceByUID(ctx context.Context, name, uid string) (*oapi.Kubenamespace, error)
+	CreateNamespace(ctx context.Context, namespace oapi.Kubenamespace) error
+}
+
+type Clusters interface {
+	// FindCluster returns the Kubecluster with the given name, nil if it doesn't exist:
+	// an error is returned if multiple Kubeclusters share the name.
+	FindCluster(ctx context.Context, name string) (*oapi.Kubecluster, error)
+}
+
+type Backups interface {
+	GetBackup(ctx context.Context, id string) (*oapi.Kubebackup, error)
+	ListBackups(ctx context.Context, filter BackupFilter) ([]oapi.Kubebackup, error)
+	// FindBackup returns the Kubebackup with the given name, nil if it doesn't exist:
+	// an error is returned if multiple Kubebackups share the name.
+	FindBackup(ctx context.Context, name string) (*oapi.Kubebackup, error)
+	// EnsureBackup returns the Kubebackup with the name of the given one, creating it when missing:
+	// an error is returned if the Kubebackup cannot be retrieved along with its ID.
+	EnsureBackup(ctx context.Context, backup oapi.Kubebackup) (*oapi.Kubebackup, error)
+	// RunBackup starts the Kubebackup with the given ID, retaining the backup for the given days.
+	RunBackup(ctx context.Context, id, name string, retentionDays int) error
+	// UpdateBackup updates the Kubebackup with the given ID with the fields of the given one.
+	UpdateBackup(ctx context.Context, id string, backup oapi.Kubebackup) error
+	// DeleteBackup deletes the Kubebackup, tolerating it has been already deleted.
+	DeleteBackup(ctx context.Context, id string) error
+	GetBackupInstance(ctx context.Context, id string) (*oapi.Backupinstance, error)
+}
+
+type Restores interface {
+	// EnsureRestore returns the Kuberestore with the name of the given one, creating it when missing:
+	// the creation starts the restore, and an error is returned if the Kuberestore cannot be retrieved along with its ID.
+	EnsureRestore(ctx context.Context, restore oapi.Kuberestore) (*oapi.Kuberestore, error)
+}
+
+type Policies interface {
+	// EnsurePolicy returns the Policy with the name of the given one, creating it when missing:
+	// an error is returned if the Policy cannot be retrieved along with its ID.
+	EnsurePolicy(ctx context.Context, policy oapi.Policy) (*oapi.Policy, error)
+	// ReplacePolicy replaces the Policy with the given ID with the given one.
+	ReplacePolicy(ctx context.Context, id string, policy oapi.Policy) error
+	// DeletePolicy deletes the Policy, tolerating it has been already deleted.
+	DeletePolicy(ctx context.Context, id string) error
+}
+
+type Jobs interface {
+	GetJob(ctx context.Context, id string) (*oapi.Job, error)
+	// FindLatestJob returns the most recently started Job matching the filter, nil if there's none.
+	FindLatestJob(ctx context.Context, filter JobFilter) (*oapi.Job, error)
+}
+
+type Orgs interface {
+	ListOrgs(ctx context.Context) ([]oapi.Org, error)
+}
+
+// InviteFilter selects the Orginvites matching all the non-empty fields.
+type InviteFilter struct {
+	Email string
+	State oapi.OrginviteState
+	Tags  map[string]string
+}
+
+// UserFilter selects the Users matching all the non-empty fields.
+type UserFilter struct {
+	Email string
+	IDs   []string
+}
+
+// BackupFilter selects the Kubebackups matching all the non-empty fields.
+type BackupFilter struct {
+	Name      string
+	ClusterID string
+	Tags      map[string]string
+}
+
+// JobFilter selects the Jobs matching all the non-empty fields.
+type JobFilter struct {
+	// Name of the Job, such as the one given to RunBackup.
+	Name      string
+	BackupID  string
+	RestoreID string
+}
@@ -0,0 +1,145 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Filter is an Eve-style where clause, supporting dotted paths, the $in, $nin, $ne, and $exists operators,
+// along with the $and and $or logical ones.
+type Filter map[string]interface{}
+
+func ParseFilter(where string) (Filter, error) {
+	if len(where) == 0 {
+		return Filter{}, nil
+	}
+
+	filter := Filter{}
+
+	if err := json.Unmarshal([]byte(where), &filter); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func (f Filter) Match(doc Document) bool {
+	for key, condition := range f {
+		switch key {
+		case "$and", "$or":
+			clauses, _ := condition.([]interface{})
+
+			matched := 0
+
+			for _, clause := range clauses {
+				m, _ := clause.(map[string]interface{})
+				if Filter(m).Match(doc) {
+					matched++
+				}
+			}
+
+			if key == "$and" && matched != len(clauses) || key == "$or" && matched == 0 {
+				return false
+			}
+		default:
+			value, exists := lookup(doc, key)
+			if !matchCondition(value, exists, normalize(condition)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func lookup(doc map[string]interface{}, path string) (interface{}, bool) {
+	var current interface{} = doc
+
+	for _, field := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		if current, ok = m[field]; !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func matchCondition(value interface{}, exists bool, condition interface{}) bool {
+	operators, ok := condition.(map[string]interface{})
+	if !ok || !isOperator(operators) {
+		return exists && equals(value, condition)
+	}
+
+	for operator, operand := range operators {
+		switch operator {
+		case "$in":
+			if !exists || !in(value, operand) {
+				return false
+			}
+		case "$nin":
+			if exists && in(value, operand) {
+				return false
+			}
+		case "$ne":
+			if exists && equals(value, operand) {
+				return false
+			}
+		case "$exists":
+			if expected, _ := operand.(bool); expected != exists {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isOperator(m map[string]interface{}) bool {
+	for k := range m {
+		if !strings.HasPrefix(k, "$") {
+			return false
+		}
+	}
+
+	return len(m) > 0
+}
+
+// equals compares the values as MongoDB does: arrays match when containing the expected value.
+func equals(value, expected interface{}) bool {
+	if reflect.DeepEqual(value, expected) {
+		return true
+	}
+
+	if items, ok := value.([]interface{}); ok {
+		for _, item := range items {
+			if reflect.DeepEqual(item, expected) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func in(value, operand interface{}) bool {
+	candidates, _ := operand.([]interface{})
+
+	for _, candidate := range candidates {
+		if equals(value, candidate) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,470 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+// Package simulator implements an in-memory CloudCasa API server, limited to the resources used by the addon,
+// honouring the ETag preconditions, the Eve-style where filters, and the pagination.
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	UserGroups      = "usergroups"
+	OrgInvites      = "orginvites"
+	KubeNamespaces  = "kubenamespaces"
+	KubeClusters    = "kubeclusters"
+	KubeBackups     = "kubebackups"
+	RunKubeBackup   = "runkubebackup"
+	BackupInstances = "backupinstances"
+	KubeRestores    = "kuberestores"
+	Policies        = "policies"
+	Jobs            = "jobs"
+	Orgs            = "orgs"
+	Users           = "users"
+
+	defaultMaxResults = 25
+	// paginationLimit is the upper bound to the requested page size, as enforced by Eve
+	paginationLimit = 50
+)
+
+type Simulator struct {
+	// Token is the bearer token required to authenticate the requests, none if empty.
+	Token string
+	// RejectNamespaceRegistration refuses the creation of Kubenamespaces, as done for the clusters
+	// where these are discovered by the CloudCasa agent only.
+	RejectNamespaceRegistration bool
+
+	store *store
+}
+
+func New() *Simulator {
+	return &Simulator{store: newStore()}
+}
+
+// NewServer starts a test HTTP server for the given Simulator: the URL must be used as the CloudCasa API server one.
+func NewServer(s *Simulator) *httptest.Server {
+	return httptest.NewServer(s)
+}
+
+// Insert stores the document in the given collection, returning its ID.
+func (s *Simulator) Insert(collection string, doc Document) string {
+	return s.store.insert(collection, doc)["_id"].(string)
+}
+
+// Get returns the document of the given collection, false if it doesn't exist.
+func (s *Simulator) Get(collection, id string) (Document, bool) {
+	return s.store.get(collection, id)
+}
+
+// List returns the documents of the given collection matching the filter.
+func (s *Simulator) List(collection string, filter Filter) []Document {
+	return s.store.list(collection, filter)
+}
+
+// Update changes the given fields of the document, as done by an administrator, generating a new ETag.
+func (s *Simulator) Update(collection, id string, fields Document) bool {
+	_, code := s.store.update(collection, id, nil, func(doc Document) {
+		for k, v := range copyDocument(fields) {
+			doc[k] = v
+		}
+	})
+
+	return code == 0
+}
+
+// AcceptInvitation simulates the acceptance of the pending invitations sent to the given email,
+// creating the User if missing.
+func (s *Simulator) AcceptInvitation(email string) {
+	for _, invite := range s.store.list(OrgInvites, Filter{"email": email, "state": "PENDING"}) {
+		s.Update(OrgInvites, invite["_id"].(string), Document{"state": "ACCEPTED"})
+	}
+
+	if len(s.store.list(Users, Filter{"email": email})) == 0 {
+		s.Insert(Users, Document{"email": email, "name": email})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]interface{}{
+		"_status": "ERR",
+		"_error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeOK(w http.ResponseWriter, code int, doc Document) {
+	w.Header().Set("ETag", doc["_etag"].(string))
+
+	writeJSON(w, code, map[string]interface{}{
+		"_status":  "OK",
+		"_id":      doc["_id"],
+		"_etag":    doc["_etag"],
+		"_created": doc["_created"],
+		"_updated": doc["_updated"],
+	})
+}
+
+func (s *Simulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if len(s.Token) > 0 && r.Header.Get("Authorization") != fmt.Sprintf("Bearer %s", s.Token) {
+		writeError(w, http.StatusUnauthorized, "Please provide proper credentials")
+
+		return
+	}
+	// Paths are in the form /v1/{collection}[/{id}[/action/update-acls]], optionally prefixed by the API base path
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	for len(parts) > 0 && parts[0] != "v1" {
+		parts = parts[1:]
+	}
+
+	if len(parts) < 2 || !s.isCollection(parts[1]) {
+		writeError(w, http.StatusNotFound, "The requested URL was not found on the server.")
+
+		return
+	}
+
+	collection := parts[1]
+
+	switch {
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		s.list(w, r, collection)
+	case len(parts) == 2 && r.Method == http.MethodPost:
+		s.create(w, r, collection)
+	case len(parts) == 3 && r.Method == http.MethodGet:
+		s.get(w, collection, parts[2])
+	case len(parts) == 3 && (r.Method == http.MethodPatch || r.Method == http.MethodPut):
+		s.replace(w, r, collection, parts[2], r.Method == http.MethodPatch)
+	case len(parts) == 3 && r.Method == http.MethodDelete:
+		s.delete(w, r, collection, parts[2])
+	case len(parts) == 5 && collection == UserGroups && parts[3] == "action" && parts[4] == "update-acls" && r.Method == http.MethodPost:
+		s.updateACLs(w, r, parts[2])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "The method is not allowed for the requested URL.")
+	}
+}
+
+func (s *Simulator) isCollection(name string) bool {
+	switch name {
+	case UserGroups, OrgInvites, KubeNamespaces, KubeClusters, KubeBackups, RunKubeBackup, BackupInstances, KubeRestores, Policies, Jobs, Orgs, Users:
+		return true
+	default:
+		return false
+	}
+}
+
+func intParam(r *http.Request, name string, fallback int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil || value < 1 {
+		return fallback
+	}
+
+	return value
+}
+
+func (s *Simulator) list(w http.ResponseWriter, r *http.Request, collection string) {
+	filter, err := ParseFilter(r.URL.Query().Get("where"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Unable to parse `where` clause: %s", err.Error()))
+
+		return
+	}
+
+	docs := s.store.list(collection, filter)
+
+	page, maxResults := intParam(r, "page", 1), intParam(r, "max_results", defaultMaxResults)
+	if maxResults > paginationLimit {
+		maxResults = paginationLimit
+	}
+
+	start := (page - 1) * maxResults
+	if start > len(docs) {
+		start = len(docs)
+	}
+
+	end := start + maxResults
+	if end > len(docs) {
+		end = len(docs)
+	}
+
+	links := map[string]interface{}{
+		"parent": map[string]interface{}{"href": "/", "title": "home"},
+		"self":   map[string]interface{}{"href": collection, "title": collection},
+	}
+
+	if end < len(docs) {
+		links["next"] = map[string]interface{}{"href": fmt.Sprintf("%s?page=%d", collection, page+1), "title": "next page"}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"_items": docs[start:end],
+		"_links": links,
+		"_meta": map[string]interface{}{
+			"max_results": maxResults,
+			"page":        page,
+			"total":       len(docs),
+		},
+	})
+}
+
+func decode(r *http.Request) (Document, error) {
+	doc := Document{}
+
+	if err := json.NewDecoder(r.Body).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	for k := range doc {
+		// Meta fields are managed by the server
+		if strings.HasPrefix(k, "_") {
+			delete(doc, k)
+		}
+	}
+
+	return doc, nil
+}
+
+func (s *Simulator) create(w http.ResponseWriter, r *http.Request, collection string) {
+	if collection == KubeNamespaces && s.RejectNamespaceRegistration {
+		writeError(w, http.StatusMethodNotAllowed, "The method is not allowed for the requested URL.")
+
+		return
+	}
+
+	doc, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	switch collection {
+	case OrgInvites:
+		doc["state"] = "PENDING"
+	case KubeBackups:
+		if policyID, ok := doc["policy"].(string); ok {
+			if _, ok = s.store.get(Policies, policyID); !ok {
+				writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("value '%s' must exist in resource 'policies', field '_id'", policyID))
+
+				return
+			}
+		}
+	case RunKubeBackup:
+		backupID, _ := doc["backup"].(string)
+
+		backup, ok := s.store.get(KubeBackups, backupID)
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("value '%s' must exist in resource 'kubebackups', field '_id'", backupID))
+
+			return
+		}
+
+		s.runBackup(doc, backup)
+	case KubeRestores:
+		instanceID, _ := doc["backup_inst"].(string)
+
+		if _, ok := s.store.get(BackupInstances, instanceID); !ok {
+			writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("value '%s' must exist in resource 'backupinstances', field '_id'", instanceID))
+
+			return
+		}
+
+		writeOK(w, http.StatusCreated, s.runRestore(doc))
+
+		return
+	}
+
+	writeOK(w, http.StatusCreated, s.store.insert(collection, doc))
+}
+
+// runBackup records the Job of the Kubebackup run, completed right away since no agent is running the backup,
+// along with the backup instance of the Kubebackup source.
+func (s *Simulator) runBackup(run, backup Document) {
+	now := time.Now().Unix()
+
+	instance := s.store.insert(BackupInstances, Document{
+		"name":             run["name"],
+		"source_type":      "kubernetes",
+		"state":            "READY",
+		"cluster":          backup["cluster"],
+		"backupdef":        backup["_id"],
+		"backupdef_name":   backup["name"],
+		"backup_selection": backup["source"],
+		"tags":             backup["tags"],
+		"retention":        run["retention"],
+		"start_time":       now,
+		"end_time":         now,
+	})
+
+	s.store.insert(Jobs, Document{
+		"name":                 run["name"],
+		"type":                 "K8S_SNAP",
+		"jobrunner":            "simulator",
+		"cluster":              backup["cluster"],
+		"backupdef":            backup["_id"],
+		"backupdef_name":       backup["name"],
+		"backup_inst":          instance["_id"],
+		"retention":            run["retention"],
+		"state":                "COMPLETED",
+		"start_time":           now,
+		"end_time":             now,
+		"num_total_resources":  0,
+		"num_backup_resources": 0,
+		"num_errors":           0,
+		"num_warnings":         0,
+	})
+}
+
+// runRestore stores the Kuberestore along with its Job, completed right away since no agent is running the restore.
+func (s *Simulator) runRestore(restore Document) Document {
+	now := time.Now().Unix()
+
+	restore = s.store.insert(KubeRestores, restore)
+
+	job := s.store.insert(Jobs, Document{
+		"name":                   restore["name"],
+		"type":                   "RESTORE",
+		"jobrunner":              "simulator",
+		"cluster":                restore["cluster"],
+		"restoredef":             restore["_id"],
+		"restoredef_name":        restore["name"],
+		"backup_inst":            restore["backup_inst"],
+		"state":                  "COMPLETED",
+		"start_time":             now,
+		"end_time":               now,
+		"num_total_resources":    0,
+		"num_restored_resources": 0,
+		"num_errors":             0,
+		"num_warnings":           0,
+	})
+
+	restore, _ = s.store.update(KubeRestores, restore["_id"].(string), nil, func(doc Document) {
+		doc["status"] = map[string]interface{}{"state": "JOB_CREATED", "jobid": job["_id"]}
+	})
+
+	return restore
+}
+
+func (s *Simulator) get(w http.ResponseWriter, collection, id string) {
+	doc, ok := s.store.get(collection, id)
+	if !ok {
+		writeError(w, http.StatusNotFound, "The requested URL was not found on the server.")
+
+		return
+	}
+
+	w.Header().Set("ETag", doc["_etag"].(string))
+
+	writeJSON(w, http.StatusOK, doc)
+}
+
+var preconditionMessages = map[int]string{
+	http.StatusNotFound:             "The requested URL was not found on the server.",
+	http.StatusPreconditionRequired: "To edit a document its etag must be provided using the If-Match header",
+	http.StatusPreconditionFailed:   "Client and server etags don't match",
+}
+
+func ifMatch(r *http.Request) *string {
+	value := r.Header.Get("If-Match")
+
+	return &value
+}
+
+func (s *Simulator) replace(w http.ResponseWriter, r *http.Request, collection, id string, merge bool) {
+	patch, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	doc, code := s.store.update(collection, id, ifMatch(r), func(doc Document) {
+		if !merge {
+			for k := range doc {
+				if !strings.HasPrefix(k, "_") {
+					delete(doc, k)
+				}
+			}
+		}
+
+		for k, v := range patch {
+			doc[k] = v
+		}
+	})
+	if code != 0 {
+		writeError(w, code, preconditionMessages[code])
+
+		return
+	}
+
+	writeOK(w, http.StatusOK, doc)
+}
+
+func (s *Simulator) delete(w http.ResponseWriter, r *http.Request, collection, id string) {
+	if code := s.store.delete(collection, id, ifMatch(r)); code != 0 {
+		writeError(w, code, preconditionMessages[code])
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// updateACLs replaces the UserGroup ACLs: the roles IDs of the request are expanded into objects, as CloudCasa does.
+func (s *Simulator) updateACLs(w http.ResponseWriter, r *http.Request, id string) {
+	body, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	acls, _ := copyDocument(body)["acls"].([]interface{})
+
+	for _, item := range acls {
+		acl, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if roles, ok := acl["roles"].([]interface{}); ok {
+			expanded := make([]interface{}, 0, len(roles))
+
+			for _, role := range roles {
+				expanded = append(expanded, map[string]interface{}{"id": role})
+			}
+
+			acl["roles"] = expanded
+		}
+	}
+
+	doc, code := s.store.update(UserGroups, id, ifMatch(r), func(doc Document) {
+		doc["acls"] = acls
+	})
+	if code != 0 {
+		writeError(w, code, preconditionMessages[code])
+
+		return
+	}
+
+	w.Header().Set("ETag", doc["_etag"].(string))
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"_id":  id,
+		"acls": body["acls"],
+	})
+}
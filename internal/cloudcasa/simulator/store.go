@@ -0,0 +1,170 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Document is a resource stored by the simulator, as decoded from its JSON representation.
+type Document map[string]interface{}
+
+// store keeps the resources of each collection in memory, along with their ETags.
+type store struct {
+	mutex       sync.RWMutex
+	collections map[string]map[string]Document
+}
+
+func newStore() *store {
+	return &store{collections: map[string]map[string]Document{}}
+}
+
+func newID() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// normalize converts any value to its JSON representation, allowing the comparison with the decoded documents.
+func normalize(in interface{}) interface{} {
+	value, err := json.Marshal(in)
+	if err != nil {
+		return in
+	}
+
+	var out interface{}
+
+	if err = json.Unmarshal(value, &out); err != nil {
+		return in
+	}
+
+	return out
+}
+
+func copyDocument(doc Document) Document {
+	out, _ := normalize(doc).(map[string]interface{})
+
+	return out
+}
+
+// touch updates the document metadata, generating a new ETag.
+func touch(doc Document) {
+	now := time.Now().UTC().Format(time.RFC1123)
+
+	if _, ok := doc["_created"]; !ok {
+		doc["_created"] = now
+	}
+
+	doc["_updated"] = now
+	doc["_etag"] = newID()
+}
+
+func (s *store) insert(collection string, doc Document) Document {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	doc = copyDocument(doc)
+
+	if id, ok := doc["_id"].(string); !ok || len(id) == 0 {
+		doc["_id"] = newID()
+	}
+
+	touch(doc)
+
+	if s.collections[collection] == nil {
+		s.collections[collection] = map[string]Document{}
+	}
+
+	s.collections[collection][doc["_id"].(string)] = doc
+
+	return copyDocument(doc)
+}
+
+func (s *store) get(collection, id string) (Document, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	doc, ok := s.collections[collection][id]
+	if !ok {
+		return nil, false
+	}
+
+	return copyDocument(doc), true
+}
+
+// precondition returns the HTTP status code of the failed precondition for the given document, zero if satisfied:
+// an empty If-Match value skips the check, used for the changes not performed through the API.
+func precondition(doc Document, exists bool, ifMatch *string) int {
+	switch {
+	case !exists:
+		return http.StatusNotFound
+	case ifMatch == nil:
+		return 0
+	case len(*ifMatch) == 0:
+		return http.StatusPreconditionRequired
+	case *ifMatch != doc["_etag"]:
+		return http.StatusPreconditionFailed
+	default:
+		return 0
+	}
+}
+
+// update changes the document through the given function, generating a new ETag.
+func (s *store) update(collection, id string, ifMatch *string, fn func(doc Document)) (Document, int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	doc, ok := s.collections[collection][id]
+	if code := precondition(doc, ok, ifMatch); code != 0 {
+		return nil, code
+	}
+
+	fn(doc)
+	touch(doc)
+
+	return copyDocument(doc), 0
+}
+
+func (s *store) delete(collection, id string, ifMatch *string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	doc, ok := s.collections[collection][id]
+	if code := precondition(doc, ok, ifMatch); code != 0 {
+		return code
+	}
+
+	delete(s.collections[collection], id)
+
+	return 0
+}
+
+// list returns the documents matching the filter, sorted by creation and ID for a stable pagination.
+func (s *store) list(collection string, filter Filter) []Document {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	docs := make([]Document, 0, len(s.collections[collection]))
+
+	for _, doc := range s.collections[collection] {
+		if filter.Match(doc) {
+			docs = append(docs, copyDocument(doc))
+		}
+	}
+
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i]["_id"].(string) < docs[j]["_id"].(string)
+	})
+
+	return docs
+}
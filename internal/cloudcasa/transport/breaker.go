@@ -0,0 +1,84 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+var ErrCircuitOpen = fmt.Errorf("CloudCasa API is unavailable, circuit breaker is open")
+
+// Breaker opens the circuit after the given number of consecutive failures, failing fast the requests
+// until the cool down is elapsed: then, a single trial request is allowed to close it again.
+type Breaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mutex    sync.Mutex
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// OpenFor returns the time left before the circuit allows a trial request, zero if it's closed.
+func (b *Breaker) OpenFor() time.Duration {
+	if b == nil {
+		return 0
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.openedAt.IsZero() {
+		return 0
+	}
+
+	if left := b.cooldown - b.now().Sub(b.openedAt); left > 0 {
+		return left
+	}
+
+	return 0
+}
+
+// Allow returns ErrCircuitOpen if the request must not be performed.
+func (b *Breaker) Allow() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch {
+	case b.openedAt.IsZero():
+		return nil
+	case b.now().Sub(b.openedAt) < b.cooldown, b.trial:
+		return ErrCircuitOpen
+	default:
+		b.trial = true
+
+		return nil
+	}
+}
+
+func (b *Breaker) Success() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.failures, b.openedAt, b.trial = 0, time.Time{}, false
+}
+
+func (b *Breaker) Failure() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.failures++
+
+	if b.trial || b.failures >= b.threshold {
+		b.openedAt, b.trial = b.now(), false
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+// Package transport provides the HTTP client used to interact with the CloudCasa API,
+// throttling the requests, retrying the failed ones, and failing fast when CloudCasa is down.
+package transport
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	goerr "github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/oapi"
+)
+
+type Options struct {
+	// QPS is the sustained rate of requests per second, unlimited if zero.
+	QPS   float64
+	Burst int
+	// MaxRetries is the number of attempts performed upon retriable failures, after the first one.
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// BreakerThreshold is the number of consecutive failures opening the circuit, disabled if zero.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+type Transport struct {
+	doer    oapi.HttpRequestDoer
+	options Options
+	limiter *rate.Limiter
+	breaker *Breaker
+}
+
+func New(doer oapi.HttpRequestDoer, options Options) *Transport {
+	t := &Transport{doer: doer, options: options, limiter: rate.NewLimiter(rate.Inf, 0)}
+
+	if options.QPS > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(options.QPS), options.Burst)
+	}
+
+	if options.BreakerThreshold > 0 {
+		t.breaker = NewBreaker(options.BreakerThreshold, options.BreakerCooldown)
+	}
+
+	return t
+}
+
+// Breaker returns the circuit breaker, nil if disabled.
+func (t *Transport) Breaker() *Breaker {
+	return t.breaker
+}
+
+// retriable returns true when the request can be performed again: creations are retried only when throttled,
+// since neither a server nor a transport error guarantees the resource has not been created.
+func retriable(req *http.Request, res *http.Response, err error) bool {
+	switch {
+	case req.Method == http.MethodPost:
+		return err == nil && res.StatusCode == http.StatusTooManyRequests
+	case err != nil:
+		return !goerr.Is(err, context.Canceled) && !goerr.Is(err, context.DeadlineExceeded)
+	case res.StatusCode == http.StatusTooManyRequests:
+		return true
+	default:
+		return res.StatusCode >= http.StatusInternalServerError && res.StatusCode != http.StatusNotImplemented
+	}
+}
+
+// failed returns true when the outcome must be accounted as a CloudCasa failure by the circuit breaker.
+func failed(res *http.Response, err error) bool {
+	return err != nil || res.StatusCode >= http.StatusInternalServerError
+}
+
+// delay returns the time to wait before the given retry, honouring the Retry-After header up to the maximum delay,
+// otherwise using an exponential backoff with jitter.
+func (t *Transport) delay(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if value := res.Header.Get("Retry-After"); len(value) > 0 {
+			if seconds, err := strconv.Atoi(value); err == nil {
+				return t.clamp(time.Duration(seconds) * time.Second)
+			}
+
+			if date, err := http.ParseTime(value); err == nil {
+				return t.clamp(time.Until(date))
+			}
+		}
+	}
+
+	backoff := t.options.RetryBaseDelay << uint(attempt)
+	if backoff <= 0 || backoff > t.options.RetryMaxDelay {
+		backoff = t.options.RetryMaxDelay
+	}
+
+	if backoff <= 0 {
+		return 0
+	}
+
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// clamp bounds the delay requested by the CloudCasa API server between zero and the maximum delay.
+func (t *Transport) clamp(delay time.Duration) time.Duration {
+	switch {
+	case delay < 0:
+		return 0
+	case delay > t.options.RetryMaxDelay:
+		return t.options.RetryMaxDelay
+	default:
+		return delay
+	}
+}
+
+func (t *Transport) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if t.breaker != nil {
+			if err := t.breaker.Allow(); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		res, err := t.doer.Do(req)
+
+		if t.breaker != nil {
+			if failed(res, err) {
+				t.breaker.Failure()
+			} else {
+				t.breaker.Success()
+			}
+		}
+
+		if attempt >= t.options.MaxRetries || !retriable(req, res, err) {
+			return res, err
+		}
+
+		next, rewindErr := rewind(req)
+		if rewindErr != nil {
+			return res, err
+		}
+
+		req = next
+
+		wait := t.delay(attempt, res)
+
+		if res != nil {
+			_ = res.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// rewind returns a copy of the request with a fresh body, required to perform it again.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, goerr.New("request body cannot be rewound")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Body = body
+
+	return clone, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	goerr "github.com/pkg/errors"
+)
+
+func TestDelay(t *testing.T) {
+	transport := New(nil, Options{RetryBaseDelay: time.Second, RetryMaxDelay: 10 * time.Second})
+
+	testCases := []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		min, max   time.Duration
+	}{
+		{name: "backoff of the first retry", attempt: 0, min: 500 * time.Millisecond, max: time.Second},
+		{name: "exponential backoff", attempt: 2, min: 2 * time.Second, max: 4 * time.Second},
+		{name: "backoff bounded to the maximum delay", attempt: 10, min: 5 * time.Second, max: 10 * time.Second},
+		{name: "backoff upon shift overflow", attempt: 70, min: 5 * time.Second, max: 10 * time.Second},
+		{name: "Retry-After seconds", retryAfter: "3", min: 3 * time.Second, max: 3 * time.Second},
+		{name: "Retry-After seconds beyond the maximum delay", retryAfter: "3600", min: 10 * time.Second, max: 10 * time.Second},
+		{name: "negative Retry-After seconds", retryAfter: "-5", min: 0, max: 0},
+		{name: "Retry-After date in the past", retryAfter: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), min: 0, max: 0},
+		{name: "Retry-After date beyond the maximum delay", retryAfter: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), min: 10 * time.Second, max: 10 * time.Second},
+		{name: "malformed Retry-After", attempt: 0, retryAfter: "soon", min: 500 * time.Millisecond, max: time.Second},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{}}
+			if len(tc.retryAfter) > 0 {
+				res.Header.Set("Retry-After", tc.retryAfter)
+			}
+
+			if delay := transport.delay(tc.attempt, res); delay < tc.min || delay > tc.max {
+				t.Errorf("expected delay between %s and %s, got %s", tc.min, tc.max, delay)
+			}
+		})
+	}
+}
+
+func TestDelayWithoutMaxDelay(t *testing.T) {
+	transport := New(nil, Options{RetryBaseDelay: time.Second})
+
+	if delay := transport.delay(0, nil); delay != 0 {
+		t.Errorf("expected no delay, got %s", delay)
+	}
+}
+
+// failingDoer replies with the given outcomes in order, repeating the last one.
+type failingDoer struct {
+	outcomes []func() (*http.Response, error)
+	calls    int
+}
+
+func (d *failingDoer) Do(*http.Request) (*http.Response, error) {
+	outcome := d.outcomes[len(d.outcomes)-1]
+	if d.calls < len(d.outcomes) {
+		outcome = d.outcomes[d.calls]
+	}
+
+	d.calls++
+
+	return outcome()
+}
+
+func status(code int) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return &http.Response{StatusCode: code, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}, nil
+	}
+}
+
+func unreachable() (*http.Response, error) {
+	return nil, fmt.Errorf("connection reset by peer")
+}
+
+func TestDo(t *testing.T) {
+	testCases := []struct {
+		name     string
+		method   string
+		outcomes []func() (*http.Response, error)
+		calls    int
+		status   int
+	}{
+		{name: "GET retried upon server errors", method: http.MethodGet, outcomes: []func() (*http.Response, error){status(500), unreachable, status(200)}, calls: 3, status: 200},
+		{name: "GET not retried upon client errors", method: http.MethodGet, outcomes: []func() (*http.Response, error){status(404)}, calls: 1, status: 404},
+		{name: "GET retries exhausted", method: http.MethodGet, outcomes: []func() (*http.Response, error){status(503)}, calls: 4, status: 503},
+		{name: "POST not retried upon server errors", method: http.MethodPost, outcomes: []func() (*http.Response, error){status(500)}, calls: 1, status: 500},
+		{name: "POST not retried upon transport errors", method: http.MethodPost, outcomes: []func() (*http.Response, error){unreachable}, calls: 1},
+		{name: "POST retried when throttled", method: http.MethodPost, outcomes: []func() (*http.Response, error){status(429), status(201)}, calls: 2, status: 201},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doer := &failingDoer{outcomes: tc.outcomes}
+
+			transport := New(doer, Options{MaxRetries: 3})
+
+			req, err := http.NewRequest(tc.method, "http://cloudcasa.local/api/v1/kubebackups", bytes.NewBufferString("{}"))
+			if err != nil {
+				t.Fatalf("cannot create the request: %s", err.Error())
+			}
+
+			res, err := transport.Do(req)
+
+			if doer.calls != tc.calls {
+				t.Fatalf("expected %d calls, got %d", tc.calls, doer.calls)
+			}
+
+			switch {
+			case tc.status == 0 && err == nil:
+				t.Fatalf("expected the transport error to be returned, got status %d", res.StatusCode)
+			case tc.status != 0 && (err != nil || res.StatusCode != tc.status):
+				t.Fatalf("expected status %d, got %v (%v)", tc.status, res, err)
+			}
+		})
+	}
+}
+
+func TestDoCircuitOpen(t *testing.T) {
+	doer := &failingDoer{outcomes: []func() (*http.Response, error){status(500)}}
+
+	transport := New(doer, Options{MaxRetries: 5, BreakerThreshold: 2, BreakerCooldown: time.Minute})
+
+	req, err := http.NewRequest(http.MethodGet, "http://cloudcasa.local/api/v1/kubebackups", nil)
+	if err != nil {
+		t.Fatalf("cannot create the request: %s", err.Error())
+	}
+
+	if _, err = transport.Do(req); !goerr.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the retries to be stopped by the open circuit, got %v", err)
+	}
+
+	if doer.calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", doer.calls)
+	}
+}
+
+func TestBreaker(t *testing.T) {
+	now := time.Now()
+
+	breaker := NewBreaker(2, time.Minute)
+	breaker.now = func() time.Time {
+		return now
+	}
+
+	breaker.Failure()
+
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("expected the circuit to be closed below the threshold, got %v", err)
+	}
+	// A success resets the consecutive failures
+	breaker.Success()
+	breaker.Failure()
+
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("expected the circuit to be closed after a success, got %v", err)
+	}
+
+	breaker.Failure()
+
+	if err := breaker.Allow(); !goerr.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to be open at the threshold, got %v", err)
+	}
+
+	if openFor := breaker.OpenFor(); openFor != time.Minute {
+		t.Fatalf("expected the circuit to be open for the cool down, got %s", openFor)
+	}
+	// Half-open: a single trial request is allowed once the cool down is elapsed
+	now = now.Add(time.Minute)
+
+	if openFor := breaker.OpenFor(); openFor != 0 {
+		t.Fatalf("expected the cool down to be elapsed, got %s", openFor)
+	}
+
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("expected the trial request to be allowed, got %v", err)
+	}
+
+	if err := breaker.Allow(); !goerr.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected a single trial request to be allowed, got %v", err)
+	}
+	// The failed trial opens the circuit again, regardless of the threshold
+	breaker.Failure()
+
+	if err := breaker.Allow(); !goerr.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to be open again upon the failed trial, got %v", err)
+	}
+
+	now = now.Add(time.Minute)
+
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("expected the trial request to be allowed, got %v", err)
+	}
+
+	breaker.Success()
+
+	if err := breaker.Allow(); err != nil || breaker.OpenFor() != 0 {
+		t.Fatalf("expected the circuit to be closed upon the successful trial, got %v", err)
+	}
+
+	breaker.Failure()
+
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("expected the failures to be counted from scratch once closed, got %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConfigMapKey is the ConfigMap key containing the group members Mapping:
+// the mapping can't be expressed using plain keys since group names could contain invalid characters.
+const ConfigMapKey = "groups.yaml"
+
+// ConfigMap resolves the group members from a static Mapping stored in a ConfigMap.
+type ConfigMap struct {
+	Reader         client.Reader
+	NamespacedName types.NamespacedName
+}
+
+func (c ConfigMap) Members(ctx context.Context, group string) ([]string, error) {
+	cm := &corev1.ConfigMap{}
+
+	if err := c.Reader.Get(ctx, c.NamespacedName, cm); err != nil {
+		return nil, fmt.Errorf("cannot retrieve group members ConfigMap: %w", err)
+	}
+
+	data, ok := cm.Data[ConfigMapKey]
+	if !ok {
+		return nil, fmt.Errorf("missing %s key in ConfigMap %s", ConfigMapKey, c.NamespacedName.String())
+	}
+
+	mapping, err := parse([]byte(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.members(group)
+}
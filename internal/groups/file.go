@@ -0,0 +1,30 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package groups
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// File resolves the group members from a static Mapping stored in a file:
+// it's read at every resolution, allowing to update it with no restart, as for mounted ConfigMaps.
+type File struct {
+	Path string
+}
+
+func (f File) Members(_ context.Context, group string) ([]string, error) {
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read group members file: %w", err)
+	}
+
+	mapping, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.members(group)
+}
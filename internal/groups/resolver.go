@@ -0,0 +1,42 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Resolver expands a Capsule Group owner into the emails of its members.
+type Resolver interface {
+	Members(ctx context.Context, group string) ([]string, error)
+}
+
+// Mapping is the group to emails mapping shared by the static resolvers, expressed in YAML as follows.
+//
+//	oidc:platform-team:
+//	- alice@example.com
+//	- bob@example.com
+type Mapping map[string][]string
+
+func parse(data []byte) (Mapping, error) {
+	mapping := Mapping{}
+
+	if err := yaml.Unmarshal(data, &mapping); err != nil {
+		return nil, fmt.Errorf("cannot parse group members mapping: %w", err)
+	}
+
+	return mapping, nil
+}
+
+func (m Mapping) members(group string) ([]string, error) {
+	members, ok := m[group]
+	if !ok {
+		return nil, fmt.Errorf("group %s is not defined in the members mapping", group)
+	}
+
+	return members, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package groups
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testMapping = `
+oidc:platform-team:
+- alice@clastix.io
+- bob@clastix.io
+empty: []
+`
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		data     string
+		expected Mapping
+		invalid  bool
+	}{
+		"mapping": {
+			data: testMapping,
+			expected: Mapping{
+				"oidc:platform-team": {"alice@clastix.io", "bob@clastix.io"},
+				"empty":              {},
+			},
+		},
+		"empty": {
+			data:     "",
+			expected: Mapping{},
+		},
+		"not a mapping": {
+			data:    "- alice@clastix.io",
+			invalid: true,
+		},
+		"members not a list": {
+			data:    "oidc:platform-team: alice@clastix.io",
+			invalid: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			mapping, err := parse([]byte(tc.data))
+
+			switch {
+			case tc.invalid && err == nil:
+				t.Fatalf("expected the mapping to be refused, got %v", mapping)
+			case tc.invalid:
+				return
+			case err != nil:
+				t.Fatalf("cannot parse the mapping: %s", err.Error())
+			}
+
+			if !reflect.DeepEqual(mapping, tc.expected) {
+				t.Fatalf("expected the mapping %v, got %v", tc.expected, mapping)
+			}
+		})
+	}
+}
+
+func TestResolvers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ConfigMapKey)
+	if err := os.WriteFile(path, []byte(testMapping), 0o600); err != nil {
+		t.Fatalf("cannot write the mapping file: %s", err.Error())
+	}
+
+	key := types.NamespacedName{Namespace: "capsule-system", Name: "groups"}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Data:       map[string]string{ConfigMapKey: testMapping},
+	}
+
+	resolvers := map[string]Resolver{
+		"File":      File{Path: path},
+		"ConfigMap": ConfigMap{Reader: fake.NewClientBuilder().WithObjects(cm).Build(), NamespacedName: key},
+	}
+
+	tests := map[string]struct {
+		group    string
+		expected []string
+		missing  bool
+	}{
+		"defined group":   {group: "oidc:platform-team", expected: []string{"alice@clastix.io", "bob@clastix.io"}},
+		"empty group":     {group: "empty", expected: []string{}},
+		"undefined group": {group: "oidc:developers", missing: true},
+	}
+
+	for kind, resolver := range resolvers {
+		for name, tc := range tests {
+			resolver, tc := resolver, tc
+
+			t.Run(kind+"/"+name, func(t *testing.T) {
+				members, err := resolver.Members(context.Background(), tc.group)
+
+				switch {
+				case tc.missing && err == nil:
+					t.Fatalf("expected the group to be missing, got %v", members)
+				case tc.missing:
+					return
+				case err != nil:
+					t.Fatalf("cannot resolve the group members: %s", err.Error())
+				}
+
+				if !reflect.DeepEqual(members, tc.expected) {
+					t.Fatalf("expected the members %v, got %v", tc.expected, members)
+				}
+			})
+		}
+	}
+}
+
+func TestResolversUnavailableSource(t *testing.T) {
+	resolvers := map[string]Resolver{
+		"missing file":      File{Path: filepath.Join(t.TempDir(), ConfigMapKey)},
+		"missing ConfigMap": ConfigMap{Reader: fake.NewClientBuilder().Build(), NamespacedName: types.NamespacedName{Namespace: "capsule-system", Name: "groups"}},
+		"missing ConfigMap key": ConfigMap{
+			Reader: fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "capsule-system", Name: "groups"},
+			}).Build(),
+			NamespacedName: types.NamespacedName{Namespace: "capsule-system", Name: "groups"},
+		},
+	}
+
+	for name, resolver := range resolvers {
+		resolver := resolver
+
+		t.Run(name, func(t *testing.T) {
+			if members, err := resolver.Members(context.Background(), "oidc:platform-team"); err == nil {
+				t.Fatalf("expected the resolution to fail, got %v", members)
+			}
+		})
+	}
+}
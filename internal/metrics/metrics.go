@@ -0,0 +1,29 @@
+// Copyright 2022 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "capsule_addon_cloudcasa"
+
+// UserGroupACLConflicts counts the UserGroup ACL updates rejected since the UserGroup has been changed concurrently.
+var UserGroupACLConflicts = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "usergroup_acl_conflicts_total",
+	Help:      "Number of CloudCasa UserGroup ACL updates rejected due to an ETag mismatch.",
+}, []string{"tenant"})
+
+// CacheRequests counts the CloudCasa lookups served by the cache, labelled by resource and result, either hit or miss.
+var CacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "cache_requests_total",
+	Help:      "Number of CloudCasa lookups served by the cache, or requiring an API call.",
+}, []string{"resource", "result"})
+
+func init() {
+	metrics.Registry.MustRegister(UserGroupACLConflicts, CacheRequests)
+}
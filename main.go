@@ -4,19 +4,32 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	capsulev1beta2 "github.com/clastix/capsule/api/v1beta2"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	cloudcasav1alpha1 "github.com/clastix/capsule-addon-cloudcasa/api/v1alpha1"
 	"github.com/clastix/capsule-addon-cloudcasa/controllers"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/annotations"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/auth"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/cluster"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/cloudcasa/transport"
+	"github.com/clastix/capsule-addon-cloudcasa/internal/groups"
 )
 
 var (
@@ -27,18 +40,48 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(capsulev1beta2.AddToScheme(scheme))
+	utilruntime.Must(cloudcasav1alpha1.AddToScheme(scheme))
 }
 
 func main() {
-	var metricsAddr, probeAddr, serverURL, token string
+	var metricsAddr, probeAddr, serverURL, token, tokenSecret, groupResolver, groupResolverConfigMap, groupResolverFile string
 
-	var enableLeaderElection bool
+	var clusterID, clusterIDConfigMap, clusterIDConfigMapKey, clusterName string
+
+	var enableLeaderElection, aclDryRun bool
+
+	var inventoryTimeout, cacheTTL time.Duration
+
+	var apiOptions transport.Options
+
+	var defaultBackupSchedule annotations.BackupSchedule
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	flag.StringVar(&serverURL, "cloudcasa-api-url", "https://api.cloudcasa.io/api", "The CloudCasa by Catalogic API server to interact with.")
 	flag.StringVar(&token, "cloudcasa-api-token", os.Getenv("CLOUDCASA_API_TOKEN"), "The bearer token used to interact with the CloudCasa by Catalogic API server.")
+	flag.StringVar(&tokenSecret, "cloudcasa-api-token-secret", "", "The namespace/name of the Secret containing the CloudCasa by Catalogic API token in the key token, reloaded upon changes: takes precedence over --cloudcasa-api-token.")
+	flag.Float64Var(&apiOptions.QPS, "cloudcasa-api-qps", 5, "The maximum sustained rate of requests per second to the CloudCasa API, unlimited if zero.")
+	flag.IntVar(&apiOptions.Burst, "cloudcasa-api-burst", 10, "The maximum burst of requests to the CloudCasa API.")
+	flag.IntVar(&apiOptions.MaxRetries, "cloudcasa-api-max-retries", 3, "The number of retries for the CloudCasa API requests failed with a retriable status.")
+	flag.DurationVar(&apiOptions.RetryBaseDelay, "cloudcasa-api-retry-base-delay", 500*time.Millisecond, "The initial delay between retries, doubled for each retry and jittered, unless the server sends Retry-After.")
+	flag.DurationVar(&apiOptions.RetryMaxDelay, "cloudcasa-api-retry-max-delay", 30*time.Second, "The maximum delay between retries.")
+	flag.IntVar(&apiOptions.BreakerThreshold, "cloudcasa-api-breaker-threshold", 5, "The number of consecutive CloudCasa API failures pausing the reconciliation, disabled if zero.")
+	flag.DurationVar(&apiOptions.BreakerCooldown, "cloudcasa-api-breaker-cooldown", time.Minute, "The time the reconciliation is paused once the CloudCasa API is considered unavailable.")
+	flag.DurationVar(&cacheTTL, "cloudcasa-cache-ttl", 30*time.Second, "The time the CloudCasa UserGroups, Namespaces, and Organizations are cached for, disabled if zero.")
+	flag.StringVar(&clusterID, "cloudcasa-cluster-id", "", "The CloudCasa ID of the cluster, used for the Tenants without the cloudcasa.io/clusterid annotation: discovered at startup if empty.")
+	flag.StringVar(&clusterIDConfigMap, "cloudcasa-cluster-id-configmap", "", "The namespace/name of the ConfigMap written by the CloudCasa agent containing the cluster ID, used for the discovery.")
+	flag.StringVar(&clusterIDConfigMapKey, "cloudcasa-cluster-id-configmap-key", "cluster_id", "The key of the ConfigMap containing the cluster ID.")
+	flag.StringVar(&clusterName, "cloudcasa-cluster-name", "", "The name of the CloudCasa Kubecluster, used for the discovery when the ConfigMap is not available.")
+	flag.StringVar(&defaultBackupSchedule.Cron, "default-backup-schedule", "", "The cron expression, in the five fields format, of the default backups of the Tenants: disabled if empty.")
+	flag.IntVar(&defaultBackupSchedule.RetentionDays, "default-backup-retention-days", 7, "The number of days the default backups of the Tenants are retained for.")
+	flag.StringVar(&defaultBackupSchedule.Timezone, "default-backup-timezone", "UTC", "The timezone the default backups schedule is evaluated in.")
+	flag.BoolVar(&aclDryRun, "acl-dry-run", false, "Log the computed CloudCasa UserGroup ACL diff without applying it.")
+	flag.DurationVar(&inventoryTimeout, "namespace-inventory-timeout", 30*time.Minute, "The time after which a Tenant Namespace still not discovered by CloudCasa is reported as not inventoried.")
+	flag.StringVar(&groupResolver, "group-resolver", "none", "The resolver used to expand Group owners into their members emails, one of none, configmap, or file.")
+	flag.StringVar(&groupResolverConfigMap, "group-resolver-configmap", "", "The namespace/name of the ConfigMap containing the Group members mapping, required by the configmap resolver.")
+	flag.StringVar(&groupResolverFile, "group-resolver-file", "", "The path of the file containing the Group members mapping, required by the file resolver.")
 
 	opts := zap.Options{
 		Development: true,
@@ -53,12 +96,24 @@ func main() {
 		os.Exit(1)
 	}
 
-	if len(token) == 0 {
+	if len(token) == 0 && len(tokenSecret) == 0 {
 		setupLog.Info("the CloudCasa by Catalogic token is a required parameter")
 		os.Exit(1)
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	if len(defaultBackupSchedule.Cron) > 0 && len(strings.Fields(defaultBackupSchedule.Cron)) != 5 {
+		setupLog.Info("the default backups schedule must be a cron expression in the five fields format")
+		os.Exit(1)
+	}
+
+	if defaultBackupSchedule.RetentionDays < 1 {
+		setupLog.Info("the default backups retention must be of at least one day")
+		os.Exit(1)
+	}
+
+	cfg := ctrl.GetConfigOrDie()
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:                 scheme,
 		MetricsBindAddress:     metricsAddr,
 		Port:                   9443,
@@ -71,16 +126,116 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&controllers.Manager{}).SetupWithManager(serverURL, token, mgr); err != nil {
+	var resolver groups.Resolver
+
+	switch groupResolver {
+	case "none":
+	case "configmap":
+		parts := strings.Split(groupResolverConfigMap, "/")
+		if len(parts) != 2 {
+			setupLog.Info("the Group members ConfigMap must be expressed in the namespace/name format")
+			os.Exit(1)
+		}
+
+		resolver = groups.ConfigMap{Reader: mgr.GetAPIReader(), NamespacedName: types.NamespacedName{Namespace: parts[0], Name: parts[1]}}
+	case "file":
+		if len(groupResolverFile) == 0 {
+			setupLog.Info("the Group members file is a required parameter for the file resolver")
+			os.Exit(1)
+		}
+
+		resolver = groups.File{Path: groupResolverFile}
+	default:
+		setupLog.Info("unsupported Group resolver, must be one of none, configmap, or file")
+		os.Exit(1)
+	}
+
+	apiToken := auth.NewToken(token)
+
+	if len(tokenSecret) > 0 {
+		parts := strings.SplitN(tokenSecret, "/", 2)
+		if len(parts) != 2 {
+			setupLog.Info("the CloudCasa by Catalogic token Secret must be in the namespace/name format")
+			os.Exit(1)
+		}
+
+		watcher := &auth.SecretWatcher{
+			Token:          apiToken,
+			Clientset:      kubernetes.NewForConfigOrDie(cfg),
+			NamespacedName: types.NamespacedName{Namespace: parts[0], Name: parts[1]},
+		}
+
+		if err = watcher.Load(context.Background(), mgr.GetAPIReader()); err != nil {
+			setupLog.Error(err, "unable to load the CloudCasa by Catalogic token from the Secret")
+			os.Exit(1)
+		}
+
+		if err = mgr.Add(watcher); err != nil {
+			setupLog.Error(err, "unable to set up the CloudCasa by Catalogic token Secret watcher")
+			os.Exit(1)
+		}
+	}
+
+	apiTransport := transport.New(http.DefaultClient, apiOptions)
+
+	apiClient, err := cloudcasa.NewClient(serverURL, apiToken, apiTransport)
+	if err != nil {
+		setupLog.Error(err, "unable to create CloudCasa client")
+		os.Exit(1)
+	}
+
+	var cc cloudcasa.Service = apiClient
+	if cacheTTL > 0 {
+		cc = cloudcasa.NewCache(apiClient, cacheTTL)
+	}
+
+	if len(clusterID) == 0 {
+		discovery := cluster.Discovery{Reader: mgr.GetAPIReader(), Service: cc, ConfigMapKey: clusterIDConfigMapKey, ClusterName: clusterName}
+
+		if len(clusterIDConfigMap) > 0 {
+			parts := strings.SplitN(clusterIDConfigMap, "/", 2)
+			if len(parts) != 2 {
+				setupLog.Info("the CloudCasa cluster ID ConfigMap must be in the namespace/name format")
+				os.Exit(1)
+			}
+
+			discovery.ConfigMap = types.NamespacedName{Namespace: parts[0], Name: parts[1]}
+		}
+
+		if clusterID, err = discovery.ClusterID(ctrl.LoggerInto(context.Background(), setupLog)); err != nil {
+			setupLog.Error(err, "unable to discover the CloudCasa cluster ID")
+		}
+	}
+
+	if len(clusterID) == 0 {
+		setupLog.Info("the CloudCasa cluster ID cannot be discovered, only the Tenants with the cloudcasa.io/clusterid annotation are reconciled")
+	}
+
+	if err = (&controllers.Manager{GroupResolver: resolver, ACLDryRun: aclDryRun, InventoryTimeout: inventoryTimeout, Breaker: apiTransport.Breaker(), DefaultClusterID: clusterID, DefaultBackupSchedule: defaultBackupSchedule}).SetupWithManager(cc, mgr); err != nil {
 		setupLog.Error(err, "unable to set up *capsulev1beta2.Tenant controller")
 		os.Exit(1)
 	}
 
-	if err = (&controllers.Namespace{}).SetupWithManager(mgr); err != nil {
+	if err = (&controllers.Namespace{DefaultClusterID: clusterID}).SetupWithManager(cc, mgr); err != nil {
 		setupLog.Error(err, "unable to set up *corev1.Namespace controller")
 		os.Exit(1)
 	}
 
+	if err = (&controllers.TenantBackup{DefaultClusterID: clusterID}).SetupWithManager(cc, mgr); err != nil {
+		setupLog.Error(err, "unable to set up *cloudcasav1alpha1.TenantBackup controller")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.TenantRestore{DefaultClusterID: clusterID}).SetupWithManager(cc, mgr); err != nil {
+		setupLog.Error(err, "unable to set up *cloudcasav1alpha1.TenantRestore controller")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.TenantBackupPolicy{DefaultClusterID: clusterID}).SetupWithManager(cc, mgr); err != nil {
+		setupLog.Error(err, "unable to set up *cloudcasav1alpha1.TenantBackupPolicy controller")
+		os.Exit(1)
+	}
+
 	if err = mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -91,6 +246,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = mgr.AddReadyzCheck("cloudcasa-token", apiToken.Check); err != nil {
+		setupLog.Error(err, "unable to set up CloudCasa token ready check")
+		os.Exit(1)
+	}
+
 	setupLog.Info("starting manager")
 
 	if err = mgr.Start(ctrl.SetupSignalHandler()); err != nil {